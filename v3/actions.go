@@ -0,0 +1,79 @@
+package termui
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Actionable is implemented by widgets that can be controlled by name, e.g. by automation
+// scripts, macros or a remote control, as in widget.Do("selectRow", 5).
+type Actionable interface {
+	// Actions returns the names of the available actions.
+	Actions() []string
+	Do(action string, args ...interface{}) error
+}
+
+// ActionFunc performs an action with the arguments passed to Do.
+type ActionFunc func(args ...interface{}) error
+
+// ActionMap maps action names to their ActionFuncs and helps widgets implement Actionable.
+type ActionMap map[string]ActionFunc
+
+// Names returns the sorted action names.
+func (self ActionMap) Names() []string {
+	names := make([]string, 0, len(self))
+	for name := range self {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (self ActionMap) Do(action string, args ...interface{}) error {
+	f, ok := self[action]
+	if !ok {
+		return fmt.Errorf("unknown action %q", action)
+	}
+	return f(args...)
+}
+
+// NoArgAction turns a method without arguments into an ActionFunc.
+func NoArgAction(f func()) ActionFunc {
+	return func(args ...interface{}) error {
+		if len(args) != 0 {
+			return fmt.Errorf("expected no arguments, got %d", len(args))
+		}
+		f()
+		return nil
+	}
+}
+
+// IntAction turns a method with an int argument into an ActionFunc.
+func IntAction(f func(int)) ActionFunc {
+	return func(args ...interface{}) error {
+		if len(args) != 1 {
+			return fmt.Errorf("expected 1 argument, got %d", len(args))
+		}
+		n, ok := args[0].(int)
+		if !ok {
+			return fmt.Errorf("expected an int argument, got %T", args[0])
+		}
+		f(n)
+		return nil
+	}
+}
+
+// StringAction turns a method with a string argument into an ActionFunc.
+func StringAction(f func(string)) ActionFunc {
+	return func(args ...interface{}) error {
+		if len(args) != 1 {
+			return fmt.Errorf("expected 1 argument, got %d", len(args))
+		}
+		s, ok := args[0].(string)
+		if !ok {
+			return fmt.Errorf("expected a string argument, got %T", args[0])
+		}
+		f(s)
+		return nil
+	}
+}
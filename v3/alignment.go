@@ -6,4 +6,7 @@ const (
 	AlignLeft Alignment = iota
 	AlignCenter
 	AlignRight
+	// AlignJustify stretches the lines of a paragraph to the full width by widening the gaps
+	// between words, except for the last line. Widgets that don't wrap text align it left.
+	AlignJustify
 )
@@ -0,0 +1,160 @@
+package termui
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	ansiEscape = '\x1b'
+	ansiBell   = '\a'
+)
+
+// ParseANSI interprets the SGR escape sequences embedded in s, e.g. the output of
+// `ls --color` or a compiler, and returns []Cell with the matching styles.
+// Uses defaultStyle for text before any sequence and after a reset.
+// Supported are bold, underline, reverse, the 16 basic colors, 256 colors and true colors,
+// which are approximated by the closest xterm color. Other escape sequences are dropped,
+// see ParseANSILinks for the OSC 8 hyperlinks.
+func ParseANSI(s string, defaultStyle Style) []Cell {
+	cells, _ := parseANSI(s, defaultStyle)
+	return cells
+}
+
+// ParseANSILinks returns the OSC 8 hyperlinks embedded in s, see Buffer.SetLinks.
+func ParseANSILinks(s string) []Link {
+	_, links := parseANSI(s, StyleClear)
+	return links
+}
+
+// parseANSI returns the cells and the hyperlinks of s.
+func parseANSI(s string, defaultStyle Style) ([]Cell, []Link) {
+	cells := []Cell{}
+	links := []Link{}
+	runes := []rune(s)
+	style := defaultStyle
+	url, linkStart := "", 0
+	endLink := func() {
+		if url != "" && len(cells) > linkStart {
+			links = append(links, Link{CellsToString(cells[linkStart:]), url})
+		}
+	}
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != ansiEscape {
+			cells = append(cells, Cell{runes[i], style})
+			continue
+		}
+		if i+1 >= len(runes) {
+			break
+		}
+		switch runes[i+1] {
+		case '[':
+			// CSI: parameters and intermediates end with a final byte in the range @ to ~
+			end := i + 2
+			for end < len(runes) && (runes[end] < '@' || runes[end] > '~') {
+				end++
+			}
+			if end < len(runes) && runes[end] == 'm' {
+				style = applySGR(style, string(runes[i+2:end]), defaultStyle)
+			}
+			i = end
+		case ']':
+			// OSC: ends with BEL or ESC \
+			end := i + 2
+			for end < len(runes) && runes[end] != ansiBell && !(runes[end] == ansiEscape && end+1 < len(runes) && runes[end+1] == '\\') {
+				end++
+			}
+			// OSC 8 hyperlinks look like `8;params;url`, an empty url ends the link
+			if parts := strings.SplitN(string(runes[i+2:end]), ";", 3); len(parts) == 3 && parts[0] == "8" {
+				endLink()
+				url, linkStart = parts[2], len(cells)
+			}
+			if end < len(runes) && runes[end] == ansiEscape {
+				end++
+			}
+			i = end
+		default:
+			i++
+		}
+	}
+	endLink()
+	return cells, links
+}
+
+// StripANSI removes all escape sequences from s.
+func StripANSI(s string) string {
+	return CellsToString(ParseANSI(s, StyleClear))
+}
+
+// applySGR applies the parameters of an SGR sequence like `1;31` to style.
+func applySGR(style Style, params string, defaultStyle Style) Style {
+	codes := []int{}
+	for _, param := range strings.Split(params, ";") {
+		code, err := strconv.Atoi(param)
+		if err != nil {
+			// an empty parameter means 0
+			code = 0
+		}
+		codes = append(codes, code)
+	}
+
+	for i := 0; i < len(codes); i++ {
+		code := codes[i]
+		switch {
+		case code == 0:
+			style = defaultStyle
+		case code == 1:
+			style.Modifier |= ModifierBold
+		case code == 4:
+			style.Modifier |= ModifierUnderline
+		case code == 7:
+			style.Modifier |= ModifierReverse
+		case code == 22:
+			style.Modifier &^= ModifierBold
+		case code == 24:
+			style.Modifier &^= ModifierUnderline
+		case code == 27:
+			style.Modifier &^= ModifierReverse
+		case code >= 30 && code <= 37:
+			style.Fg = Color(code - 30)
+		case code >= 90 && code <= 97:
+			style.Fg = Color(code - 90 + 8)
+		case code == 39:
+			style.Fg = defaultStyle.Fg
+		case code >= 40 && code <= 47:
+			style.Bg = Color(code - 40)
+		case code >= 100 && code <= 107:
+			style.Bg = Color(code - 100 + 8)
+		case code == 49:
+			style.Bg = defaultStyle.Bg
+		case code == 38 || code == 48:
+			color, n := readSGRColor(codes[i+1:])
+			if n == 0 {
+				// the remaining parameters can't be interpreted
+				return style
+			}
+			if code == 38 {
+				style.Fg = color
+			} else {
+				style.Bg = color
+			}
+			i += n
+		}
+	}
+	return style
+}
+
+// readSGRColor reads an extended color like `5;208` or `2;255;128;0` and returns it
+// together with the number of parameters read, which is 0 if they are invalid.
+func readSGRColor(codes []int) (Color, int) {
+	switch {
+	case len(codes) >= 2 && codes[0] == 5 && codes[1] >= 0 && codes[1] <= 255:
+		return Color(codes[1]), 2
+	case len(codes) >= 4 && codes[0] == 2:
+		channel := func(v int) uint8 {
+			return uint8(MaxInt(MinInt(v, 255), 0))
+		}
+		return RGBToColor(channel(codes[1]), channel(codes[2]), channel(codes[3])), 4
+	}
+	return ColorClear, 0
+}
@@ -5,6 +5,9 @@
 package termui
 
 import (
+	"image"
+	"math"
+
 	tb "github.com/nsf/termbox-go"
 )
 
@@ -21,15 +24,17 @@ func Init() error {
 
 // Close closes termbox-go.
 func Close() {
+	writeOverlays(nil, nil, image.Rect(math.MinInt32, math.MinInt32, math.MaxInt32, math.MaxInt32))
 	tb.Close()
 }
 
+// TerminalDimensions returns the size of the terminal without the SafeArea margins.
 func TerminalDimensions() (int, int) {
 	tb.Sync()
-	width, height := tb.Size()
-	return width, height
+	return safeAreaSize(tb.Size())
 }
 
 func Clear() {
+	writeOverlays(nil, nil, image.Rect(math.MinInt32, math.MinInt32, math.MaxInt32, math.MaxInt32))
 	tb.Clear(tb.ColorDefault, tb.Attribute(Theme.Default.Bg+1))
 }
@@ -103,3 +103,8 @@ func (self *Block) SetRect(x1, y1, x2, y2 int) {
 func (self *Block) GetRect() image.Rectangle {
 	return self.Rectangle
 }
+
+// GetBlock returns the Block of a widget, which allows wrappers to draw only its border and title.
+func (self *Block) GetBlock() *Block {
+	return self
+}
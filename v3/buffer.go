@@ -39,6 +39,12 @@ func NewCell(rune rune, args ...interface{}) Cell {
 type Buffer struct {
 	image.Rectangle
 	CellMap map[image.Point]Cell
+	// HitRegions are the interactive areas registered by the widgets drawn to the buffer.
+	HitRegions []HitRegion
+	// Bitmaps are the images drawn over the cells with the Graphics protocol.
+	Bitmaps []Bitmap
+	// Links are the URLs of the cells that are hyperlinks, see SetLink.
+	Links map[image.Point]string
 }
 
 func NewBuffer(r image.Rectangle) *Buffer {
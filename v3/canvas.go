@@ -2,6 +2,7 @@ package termui
 
 import (
 	"image"
+	"math"
 
 	"github.com/s-westphal/termui/v3/drawille"
 )
@@ -9,12 +10,24 @@ import (
 type Canvas struct {
 	Block
 	drawille.Canvas
+
+	// LineThickness is the width of lines drawn with SetLine in braille dots, from 1 to 3.
+	LineThickness int
+	// AntiAlias draws lines with all dots they touch and dims the color of cells that
+	// are only partly covered, so that steep lines don't look like sparse stairs.
+	AntiAlias bool
+
+	// coverage sums up how much the antialiased lines cover the dots of each cell,
+	// and counts the dots.
+	coverage map[image.Point][2]float64
 }
 
 func NewCanvas() *Canvas {
 	return &Canvas{
-		Block:  *NewBlock(),
-		Canvas: *drawille.NewCanvas(),
+		Block:         *NewBlock(),
+		Canvas:        *drawille.NewCanvas(),
+		LineThickness: 1,
+		coverage:      make(map[image.Point][2]float64),
 	}
 }
 
@@ -23,16 +36,79 @@ func (self *Canvas) SetPoint(p image.Point, color Color) {
 }
 
 func (self *Canvas) SetLine(p0, p1 image.Point, color Color) {
-	self.Canvas.SetLine(p0, p1, drawille.Color(color))
+	steep := AbsInt(p1.Y-p0.Y) > AbsInt(p1.X-p0.X)
+	// thicker lines are widened across their direction
+	offsets := []int{0, 1, -1}[:MaxInt(MinInt(self.LineThickness, 3), 1)]
+	setPoint := func(p image.Point, coverage float64) {
+		for _, offset := range offsets {
+			q := p.Add(image.Pt(0, offset))
+			if steep {
+				q = p.Add(image.Pt(offset, 0))
+			}
+			if q.X < 0 || q.Y < 0 {
+				continue
+			}
+			self.SetPoint(q, color)
+			if self.AntiAlias {
+				if self.coverage == nil {
+					self.coverage = make(map[image.Point][2]float64)
+				}
+				cell := image.Pt(q.X/2, q.Y/4)
+				c := self.coverage[cell]
+				self.coverage[cell] = [2]float64{c[0] + coverage, c[1] + 1}
+			}
+		}
+	}
+
+	if !self.AntiAlias {
+		for _, p := range drawille.Line(p0, p1) {
+			setPoint(p, 1)
+		}
+		return
+	}
+
+	// Xiaolin Wu's line algorithm, lighting both dots next to the ideal line
+	if steep {
+		p0, p1 = image.Pt(p0.Y, p0.X), image.Pt(p1.Y, p1.X)
+	}
+	if p0.X > p1.X {
+		p0, p1 = p1, p0
+	}
+	gradient := 1.0
+	if dx := p1.X - p0.X; dx != 0 {
+		gradient = float64(p1.Y-p0.Y) / float64(dx)
+	}
+	y := float64(p0.Y)
+	for x := p0.X; x <= p1.X; x, y = x+1, y+gradient {
+		yInt, fraction := math.Floor(y), y-math.Floor(y)
+		for _, dot := range []struct {
+			y        int
+			coverage float64
+		}{{int(yInt), 1 - fraction}, {int(yInt) + 1, fraction}} {
+			if dot.coverage < 0.2 {
+				continue
+			}
+			if steep {
+				setPoint(image.Pt(dot.y, x), dot.coverage)
+			} else {
+				setPoint(image.Pt(x, dot.y), dot.coverage)
+			}
+		}
+	}
 }
 
 func (self *Canvas) Draw(buf *Buffer) {
 	for point, cell := range self.Canvas.GetCells() {
 		if point.In(self.Rectangle) {
+			color := Color(cell.Color)
+			// dim partly covered cells of antialiased lines
+			if coverage, ok := self.coverage[point]; ok && coverage[1] > 0 {
+				color = InterpolateColor(ColorBlack, color, coverage[0]/coverage[1])
+			}
 			convertedCell := Cell{
 				cell.Rune,
 				Style{
-					Color(cell.Color),
+					color,
 					ColorClear,
 					ModifierClear,
 				},
@@ -0,0 +1,81 @@
+package termui
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// OSC52MaxSize is the maximum length of the base64 encoded text copied with OSC 52.
+// Many terminals ignore longer sequences, so they aren't sent.
+var OSC52MaxSize = 100000
+
+// OSC52ChunkSize is the length of the pieces the base64 encoded text is passed through tmux
+// and screen in, which limit the length of a passthrough sequence.
+var OSC52ChunkSize = 4096
+
+var ErrClipboardTooLarge = errors.New("text is too large for the OSC 52 clipboard")
+
+// IsSSHSession reports whether the app runs in an SSH session, based on the environment.
+func IsSSHSession() bool {
+	return os.Getenv("SSH_TTY") != "" || os.Getenv("SSH_CONNECTION") != "" || os.Getenv("SSH_CLIENT") != ""
+}
+
+// CopyToClipboard copies text to the system clipboard. In SSH sessions the text is sent to
+// the local terminal with OSC 52, which works without X forwarding. Otherwise the platform's
+// clipboard tool is used, falling back to OSC 52 if there is none.
+func CopyToClipboard(text string) error {
+	if !IsSSHSession() {
+		if cmd := clipboardCommand(); cmd != nil {
+			cmd.Stdin = strings.NewReader(text)
+			if err := cmd.Run(); err == nil {
+				return nil
+			}
+		}
+	}
+	return CopyToClipboardOSC52(text)
+}
+
+// CopyToClipboardOSC52 copies text to the clipboard of the terminal with an OSC 52 sequence,
+// which is passed through tmux and screen in chunks of OSC52ChunkSize.
+func CopyToClipboardOSC52(text string) error {
+	encoded := base64.StdEncoding.EncodeToString([]byte(text))
+	if len(encoded) > OSC52MaxSize {
+		return ErrClipboardTooLarge
+	}
+	var sb strings.Builder
+	sb.WriteString(WrapPassthrough("\x1b]52;c;"))
+	for chunkSize := MaxInt(OSC52ChunkSize, 1); len(encoded) > 0; {
+		n := MinInt(len(encoded), chunkSize)
+		sb.WriteString(WrapPassthrough(encoded[:n]))
+		encoded = encoded[n:]
+	}
+	sb.WriteString(WrapPassthrough("\a"))
+	_, err := fmt.Fprint(os.Stdout, sb.String())
+	return err
+}
+
+// clipboardCommand returns the command that writes its input to the clipboard, or nil if there is none.
+func clipboardCommand() *exec.Cmd {
+	candidates := [][]string{{"xclip", "-selection", "clipboard"}, {"xsel", "--clipboard", "--input"}}
+	switch runtime.GOOS {
+	case "darwin":
+		candidates = [][]string{{"pbcopy"}}
+	case "windows":
+		candidates = [][]string{{"clip"}}
+	default:
+		if os.Getenv("WAYLAND_DISPLAY") != "" {
+			candidates = append([][]string{{"wl-copy"}}, candidates...)
+		}
+	}
+	for _, candidate := range candidates {
+		if path, err := exec.LookPath(candidate[0]); err == nil {
+			return exec.Command(path, candidate[1:]...)
+		}
+	}
+	return nil
+}
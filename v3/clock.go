@@ -0,0 +1,149 @@
+package termui
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock is the source of time for timeouts, tickers and default timestamps. Replacing
+// DefaultClock with a ManualClock makes time based behavior deterministic, e.g. in tests,
+// since time only passes when the clock is advanced.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker delivers ticks on C like time.Ticker.
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+// DefaultClock is the Clock used by termui and its widgets.
+var DefaultClock Clock = SystemClock{}
+
+// SystemClock is a Clock using the time package.
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (SystemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (SystemClock) NewTicker(d time.Duration) Ticker {
+	return systemTicker{time.NewTicker(d)}
+}
+
+type systemTicker struct {
+	ticker *time.Ticker
+}
+
+func (self systemTicker) C() <-chan time.Time {
+	return self.ticker.C
+}
+
+func (self systemTicker) Stop() {
+	self.ticker.Stop()
+}
+
+// ManualClock is a Clock whose time only changes with Advance and Set.
+// Timers and tickers fire while the clock is advanced past their deadline.
+type ManualClock struct {
+	sync.Mutex
+	now    time.Time
+	timers []*manualTimer
+}
+
+type manualTimer struct {
+	clock    *ManualClock
+	deadline time.Time
+	// period is the interval of tickers and 0 for timers.
+	period  time.Duration
+	c       chan time.Time
+	stopped bool
+}
+
+func (self *manualTimer) C() <-chan time.Time {
+	return self.c
+}
+
+func (self *manualTimer) Stop() {
+	self.clock.Lock()
+	defer self.clock.Unlock()
+	self.stopped = true
+}
+
+// NewManualClock returns a ManualClock starting at the given time.
+func NewManualClock(start time.Time) *ManualClock {
+	return &ManualClock{now: start}
+}
+
+func (self *ManualClock) Now() time.Time {
+	self.Lock()
+	defer self.Unlock()
+	return self.now
+}
+
+func (self *ManualClock) After(d time.Duration) <-chan time.Time {
+	return self.add(d, 0).c
+}
+
+func (self *ManualClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for NewTicker")
+	}
+	return self.add(d, d)
+}
+
+func (self *ManualClock) add(d, period time.Duration) *manualTimer {
+	self.Lock()
+	defer self.Unlock()
+	// like time.Ticker, ticks are dropped if the receiver is too slow
+	timer := &manualTimer{clock: self, deadline: self.now.Add(d), period: period, c: make(chan time.Time, 1)}
+	self.timers = append(self.timers, timer)
+	self.fire()
+	return timer
+}
+
+// Advance moves the clock forward by d and fires the timers and tickers that are due.
+func (self *ManualClock) Advance(d time.Duration) {
+	self.Set(self.Now().Add(d))
+}
+
+// Set moves the clock to t and fires the timers and tickers that are due.
+func (self *ManualClock) Set(t time.Time) {
+	self.Lock()
+	defer self.Unlock()
+	self.now = t
+	self.fire()
+}
+
+// fire sends the ticks that are due in deadline order and removes finished timers.
+func (self *ManualClock) fire() {
+	for {
+		sort.SliceStable(self.timers, func(i, j int) bool {
+			return self.timers[i].deadline.Before(self.timers[j].deadline)
+		})
+		if len(self.timers) == 0 || self.timers[0].deadline.After(self.now) {
+			return
+		}
+		timer := self.timers[0]
+		self.timers = self.timers[1:]
+		if timer.stopped {
+			continue
+		}
+		select {
+		case timer.c <- timer.deadline:
+		default:
+		}
+		if timer.period > 0 {
+			timer.deadline = timer.deadline.Add(timer.period)
+			self.timers = append(self.timers, timer)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package termui
+
+import (
+	"image"
+)
+
+// Blit copies the cells of src inside srcRect to b, with the top left corner of srcRect at dst.
+func (self *Buffer) Blit(src *Buffer, srcRect image.Rectangle, dst image.Point) {
+	srcRect = srcRect.Intersect(src.Rectangle)
+	offset := dst.Sub(srcRect.Min)
+	for x := srcRect.Min.X; x < srcRect.Max.X; x++ {
+		for y := srcRect.Min.Y; y < srcRect.Max.Y; y++ {
+			p := image.Pt(x, y)
+			if cell, ok := src.CellMap[p]; ok && p.Add(offset).In(self.Rectangle) {
+				self.SetCell(cell, p.Add(offset))
+			}
+		}
+	}
+}
+
+// Slide returns a copy of the buffer with its content moved by offset.
+// The area uncovered by the move is filled with CellClear, content moved outside is dropped.
+func (self *Buffer) Slide(offset image.Point) *Buffer {
+	buf := NewBuffer(self.Rectangle)
+	buf.Blit(self, self.Rectangle, self.Min.Add(offset))
+	return buf
+}
+
+// BlendBuffers interpolates between two buffers of the same area, e.g. to fade between two pages.
+// t ranges from 0, which returns from, to 1, which returns to. Colors are interpolated in RGB,
+// runes are switched halfway.
+func BlendBuffers(from, to *Buffer, t float64) *Buffer {
+	t = MaxFloat64(MinFloat64(t, 1), 0)
+	buf := NewBuffer(from.Rectangle.Union(to.Rectangle))
+	for x := buf.Min.X; x < buf.Max.X; x++ {
+		for y := buf.Min.Y; y < buf.Max.Y; y++ {
+			p := image.Pt(x, y)
+			a, okA := from.CellMap[p]
+			b, okB := to.CellMap[p]
+			if !okA {
+				a = CellClear
+			}
+			if !okB {
+				b = CellClear
+			}
+			cell := a
+			if t >= 0.5 {
+				cell = b
+			}
+			cell.Style.Fg = InterpolateColor(a.Style.Fg, b.Style.Fg, t)
+			cell.Style.Bg = InterpolateColor(a.Style.Bg, b.Style.Bg, t)
+			buf.SetCell(cell, p)
+		}
+	}
+	return buf
+}
+
+// InterpolateColor returns the xterm color closest to the RGB interpolation between a and b.
+// If one of the colors is ColorClear, the colors are switched halfway instead.
+func InterpolateColor(a, b Color, t float64) Color {
+	ar, ag, ab, okA := ColorToRGB(a)
+	br, bg, bb, okB := ColorToRGB(b)
+	if a == b || !okA || !okB {
+		if t >= 0.5 {
+			return b
+		}
+		return a
+	}
+	lerp := func(x, y uint8) uint8 {
+		return uint8(RoundFloat64(float64(x) + (float64(y)-float64(x))*t))
+	}
+	return RGBToColor(lerp(ar, br), lerp(ag, bg), lerp(ab, bb))
+}
+
+// RGBToColor returns the xterm color closest to the given RGB value.
+func RGBToColor(r, g, b uint8) Color {
+	best := ColorBlack
+	bestDistance := -1
+	for c := Color(0); c < 256; c++ {
+		cr, cg, cb, _ := ColorToRGB(c)
+		dr := int(cr) - int(r)
+		dg := int(cg) - int(g)
+		db := int(cb) - int(b)
+		if distance := dr*dr + dg*dg + db*db; bestDistance == -1 || distance < bestDistance {
+			best = c
+			bestDistance = distance
+		}
+	}
+	return best
+}
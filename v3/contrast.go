@@ -0,0 +1,103 @@
+package termui
+
+import (
+	"math"
+)
+
+// xterm256Levels are the channel intensities used by the 6x6x6 color cube of the xterm palette.
+var xterm256Levels = [6]uint8{0, 95, 135, 175, 215, 255}
+
+// xterm16Colors are the RGB values of the 16 basic xterm colors.
+var xterm16Colors = [16][3]uint8{
+	{0, 0, 0},
+	{205, 0, 0},
+	{0, 205, 0},
+	{205, 205, 0},
+	{0, 0, 238},
+	{205, 0, 205},
+	{0, 205, 205},
+	{229, 229, 229},
+	{127, 127, 127},
+	{255, 0, 0},
+	{0, 255, 0},
+	{255, 255, 0},
+	{92, 92, 255},
+	{255, 0, 255},
+	{0, 255, 255},
+	{255, 255, 255},
+}
+
+// ColorToRGB returns the RGB value of an xterm color.
+// ColorClear has no defined value and returns ok == false.
+func ColorToRGB(c Color) (r, g, b uint8, ok bool) {
+	switch {
+	case c < 0 || c > 255:
+		return 0, 0, 0, false
+	case c < 16:
+		rgb := xterm16Colors[c]
+		return rgb[0], rgb[1], rgb[2], true
+	case c < 232:
+		i := int(c) - 16
+		return xterm256Levels[i/36], xterm256Levels[(i/6)%6], xterm256Levels[i%6], true
+	default:
+		gray := uint8(8 + (int(c)-232)*10)
+		return gray, gray, gray, true
+	}
+}
+
+// relativeLuminance computes the WCAG relative luminance of an xterm color.
+func relativeLuminance(c Color) float64 {
+	r, g, b, _ := ColorToRGB(c)
+	channel := func(v uint8) float64 {
+		s := float64(v) / 255
+		if s <= 0.03928 {
+			return s / 12.92
+		}
+		return math.Pow((s+0.055)/1.055, 2.4)
+	}
+	return 0.2126*channel(r) + 0.7152*channel(g) + 0.0722*channel(b)
+}
+
+// ContrastRatio returns the WCAG contrast ratio between two colors, ranging from 1 to 21.
+// If either color is ColorClear the ratio can't be known and 21 is returned.
+func ContrastRatio(fg, bg Color) float64 {
+	if _, _, _, ok := ColorToRGB(fg); !ok {
+		return 21
+	}
+	if _, _, _, ok := ColorToRGB(bg); !ok {
+		return 21
+	}
+	l1 := relativeLuminance(fg)
+	l2 := relativeLuminance(bg)
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + 0.05) / (l2 + 0.05)
+}
+
+// EnforceContrast returns the given Style with its Fg replaced by the closest xterm color
+// that has at least minRatio contrast against the Bg.
+// Styles that already satisfy minRatio, or that use ColorClear, are returned unchanged.
+func EnforceContrast(style Style, minRatio float64) Style {
+	if minRatio <= 1 || ContrastRatio(style.Fg, style.Bg) >= minRatio {
+		return style
+	}
+	fr, fg, fb, _ := ColorToRGB(style.Fg)
+	best := style.Fg
+	bestDistance := math.Inf(1)
+	for c := Color(0); c < 256; c++ {
+		if ContrastRatio(c, style.Bg) < minRatio {
+			continue
+		}
+		r, g, b, _ := ColorToRGB(c)
+		dr := float64(r) - float64(fr)
+		dg := float64(g) - float64(fg)
+		db := float64(b) - float64(fb)
+		if distance := dr*dr + dg*dg + db*db; distance < bestDistance {
+			best = c
+			bestDistance = distance
+		}
+	}
+	style.Fg = best
+	return style
+}
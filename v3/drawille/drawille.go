@@ -52,6 +52,11 @@ func (self *Canvas) GetCells() map[image.Point]Cell {
 	return cellMap
 }
 
+// Line returns the points of the line from p0 to p1.
+func Line(p0, p1 image.Point) []image.Point {
+	return line(p0, p1)
+}
+
 func line(p0, p1 image.Point) []image.Point {
 	points := []image.Point{}
 
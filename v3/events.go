@@ -6,6 +6,7 @@ package termui
 
 import (
 	"fmt"
+	"time"
 
 	tb "github.com/nsf/termbox-go"
 )
@@ -21,10 +22,11 @@ List of events:
 		<M-d> etc
 		<Up> <Down> <Left> <Right>
 		<Insert> <Delete> <Home> <End> <Previous> <Next>
-		<Backspace> <Tab> <Enter> <Escape> <Space>
+		<Backspace> <Tab> <S-Tab> <Enter> <Escape> <Space>
 		<C-<Space>> etc
 	terminal events:
         <Resize>
+        <FocusGained> <FocusLost> (see EnableFocusReporting)
 
     keyboard events that do not work:
         <C-->
@@ -45,6 +47,7 @@ const (
 	KeyboardEvent EventType = iota
 	MouseEvent
 	ResizeEvent
+	FocusEvent
 )
 
 type Event struct {
@@ -70,13 +73,65 @@ type Resize struct {
 func PollEvents() <-chan Event {
 	ch := make(chan Event)
 	go func() {
-		for {
-			ch <- convertTermboxEvent(tb.PollEvent())
+		events := make(chan tb.Event)
+		go func() {
+			for {
+				events <- tb.PollEvent()
+			}
+		}()
+		for e := range events {
+			for _, converted := range convertFocusSequence(e, events) {
+				ch <- converted
+			}
 		}
 	}()
 	return ch
 }
 
+// focusSequenceTimeout is how long to wait for the rest of a focus report after an Esc.
+// It is terminal protocol timing, so it uses real time instead of DefaultClock, which
+// may be a ManualClock that never fires.
+const focusSequenceTimeout = 10 * time.Millisecond
+
+// convertFocusSequence converts a focus report, which termbox doesn't know and delivers as the
+// keys Esc, '[' and 'I' or 'O', to a FocusEvent, and Shift+Tab, delivered as Esc, '[' and 'Z',
+// to <S-Tab>. Other events are converted as usual.
+func convertFocusSequence(e tb.Event, events <-chan tb.Event) []Event {
+	sequence := []tb.Event{e}
+	if e.Type == tb.EventKey && e.Key == tb.KeyEsc {
+		timeout := time.After(focusSequenceTimeout)
+	collect:
+		for len(sequence) < 3 {
+			select {
+			case next := <-events:
+				sequence = append(sequence, next)
+				if next.Type != tb.EventKey || next.Key != 0 {
+					break collect
+				}
+			case <-timeout:
+				break collect
+			}
+		}
+	}
+
+	if len(sequence) == 3 && sequence[1].Ch == '[' {
+		switch sequence[2].Ch {
+		case 'I':
+			return []Event{{Type: FocusEvent, ID: "<FocusGained>"}}
+		case 'O':
+			return []Event{{Type: FocusEvent, ID: "<FocusLost>"}}
+		case 'Z':
+			return []Event{{Type: KeyboardEvent, ID: "<S-Tab>"}}
+		}
+	}
+
+	converted := make([]Event, len(sequence))
+	for i, e := range sequence {
+		converted[i] = convertTermboxEvent(e)
+	}
+	return converted
+}
+
 var keyboardMap = map[tb.Key]string{
 	tb.KeyF1:         "<F1>",
 	tb.KeyF2:         "<F2>",
@@ -180,8 +235,8 @@ func convertTermboxMouseEvent(e tb.Event) Event {
 		Type: MouseEvent,
 		ID:   converted,
 		Payload: Mouse{
-			X:    e.MouseX,
-			Y:    e.MouseY,
+			X:    e.MouseX - SafeArea.Left,
+			Y:    e.MouseY - SafeArea.Top,
 			Drag: Drag,
 		},
 	}
@@ -198,12 +253,13 @@ func convertTermboxEvent(e tb.Event) Event {
 	case tb.EventMouse:
 		return convertTermboxMouseEvent(e)
 	case tb.EventResize:
+		width, height := safeAreaSize(e.Width, e.Height)
 		return Event{
 			Type: ResizeEvent,
 			ID:   "<Resize>",
 			Payload: Resize{
-				Width:  e.Width,
-				Height: e.Height,
+				Width:  width,
+				Height: height,
 			},
 		}
 	}
@@ -0,0 +1,95 @@
+package termui
+
+import (
+	"image"
+	"io"
+	"strings"
+
+	rw "github.com/mattn/go-runewidth"
+)
+
+// TextMarkers maps Modifiers to the markers wrapped around styled runs of text by RenderText.
+// Modifiers that aren't in the map are dropped.
+var TextMarkers = map[Modifier][2]string{
+	ModifierBold:      {"*", "*"},
+	ModifierUnderline: {"_", "_"},
+	ModifierReverse:   {"[", "]"},
+}
+
+// textMarkerOrder is the order in which markers are opened, they are closed in reverse.
+var textMarkerOrder = []Modifier{ModifierReverse, ModifierBold, ModifierUnderline}
+
+// RenderBuffer draws the given items into a single Buffer covering all of them,
+// in the same way Render would draw them to the terminal.
+func RenderBuffer(items ...Drawable) *Buffer {
+	rect := image.Rectangle{}
+	for _, item := range items {
+		rect = rect.Union(item.GetRect())
+	}
+	buf := NewBuffer(rect)
+	for _, item := range items {
+		itemBuf := NewBuffer(item.GetRect())
+		item.Lock()
+		item.Draw(itemBuf)
+		item.Unlock()
+		for point, cell := range itemBuf.CellMap {
+			if point.In(itemBuf.Rectangle) {
+				buf.SetCell(cell, point)
+			}
+		}
+	}
+	return buf
+}
+
+// RenderText renders the given items to plain UTF-8 text. Colors are discarded and
+// Modifiers are replaced by the markers in TextMarkers, e.g. bold text becomes *text*.
+// The output is suitable for pasting where ANSI escape codes aren't accepted.
+func RenderText(items ...Drawable) string {
+	buf := RenderBuffer(items...)
+
+	var sb strings.Builder
+	for y := buf.Min.Y; y < buf.Max.Y; y++ {
+		var line strings.Builder
+		active := ModifierClear
+		for x := buf.Min.X; x < buf.Max.X; x++ {
+			cell := buf.GetCell(image.Pt(x, y))
+			if cell.Rune == 0 {
+				cell.Rune = ' '
+			}
+			writeTextMarkers(&line, active, cell.Style.Modifier)
+			active = cell.Style.Modifier
+			line.WriteRune(cell.Rune)
+			// wide runes cover the following cell
+			x += MaxInt(rw.RuneWidth(cell.Rune), 1) - 1
+		}
+		writeTextMarkers(&line, active, ModifierClear)
+		sb.WriteString(strings.TrimRight(line.String(), " "))
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// ExportText writes the result of RenderText to w.
+func ExportText(w io.Writer, items ...Drawable) error {
+	_, err := io.WriteString(w, RenderText(items...))
+	return err
+}
+
+// writeTextMarkers closes the markers of the modifiers in from that aren't in to,
+// and then opens the markers of the modifiers in to that weren't in from.
+func writeTextMarkers(sb *strings.Builder, from, to Modifier) {
+	if from == to {
+		return
+	}
+	for i := len(textMarkerOrder) - 1; i >= 0; i-- {
+		mod := textMarkerOrder[i]
+		if from&mod != 0 && to&mod == 0 {
+			sb.WriteString(TextMarkers[mod][1])
+		}
+	}
+	for _, mod := range textMarkerOrder {
+		if from&mod == 0 && to&mod != 0 {
+			sb.WriteString(TextMarkers[mod][0])
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package termui
+
+import (
+	"image"
+)
+
+type Direction uint
+
+const (
+	DirectionUp Direction = iota
+	DirectionDown
+	DirectionLeft
+	DirectionRight
+)
+
+// FocusManager keeps track of which of a set of widgets has the focus.
+// Focus can be moved in Tab order, which is the order of Widgets, or spatially
+// to the nearest widget in a direction, based on the widget rectangles.
+type FocusManager struct {
+	Widgets []Drawable
+
+	// NextKey and PreviousKey are the event IDs that move the focus in Tab order.
+	NextKey     string
+	PreviousKey string
+	// DirectionKeys maps event IDs to the direction the focus is moved in.
+	DirectionKeys map[string]Direction
+
+	// OnFocusChange is called whenever the focused widget changes.
+	OnFocusChange func(previous, current Drawable)
+
+	focused int
+}
+
+func NewFocusManager(widgets ...Drawable) *FocusManager {
+	return &FocusManager{
+		Widgets: widgets,
+		NextKey: "<Tab>",
+		DirectionKeys: map[string]Direction{
+			"<Up>":    DirectionUp,
+			"<Down>":  DirectionDown,
+			"<Left>":  DirectionLeft,
+			"<Right>": DirectionRight,
+		},
+	}
+}
+
+// Focused returns the focused widget or nil if there are no widgets.
+func (self *FocusManager) Focused() Drawable {
+	if self.focused < 0 || self.focused >= len(self.Widgets) {
+		return nil
+	}
+	return self.Widgets[self.focused]
+}
+
+// Focus moves the focus to the given widget. It returns false if the widget isn't managed.
+func (self *FocusManager) Focus(widget Drawable) bool {
+	for i, w := range self.Widgets {
+		if w == widget {
+			self.setFocused(i)
+			return true
+		}
+	}
+	return false
+}
+
+func (self *FocusManager) setFocused(i int) {
+	previous := self.Focused()
+	self.focused = i
+	if current := self.Focused(); current != previous && self.OnFocusChange != nil {
+		self.OnFocusChange(previous, current)
+	}
+}
+
+// FocusNext moves the focus to the next widget in Tab order.
+func (self *FocusManager) FocusNext() {
+	if len(self.Widgets) > 0 {
+		self.setFocused((self.focused + 1) % len(self.Widgets))
+	}
+}
+
+// FocusPrevious moves the focus to the previous widget in Tab order.
+func (self *FocusManager) FocusPrevious() {
+	if len(self.Widgets) > 0 {
+		self.setFocused((self.focused - 1 + len(self.Widgets)) % len(self.Widgets))
+	}
+}
+
+// FocusDirection moves the focus to the nearest widget in the given direction.
+// Widgets that overlap the focused widget on the other axis are preferred.
+// It returns false if there is no widget in that direction.
+func (self *FocusManager) FocusDirection(direction Direction) bool {
+	current := self.Focused()
+	if current == nil {
+		return false
+	}
+	rect := current.GetRect()
+	center := rect.Min.Add(rect.Max).Div(2)
+
+	best := -1
+	bestScore := 0
+	for i, widget := range self.Widgets {
+		if i == self.focused {
+			continue
+		}
+		candidate := widget.GetRect()
+		candidateCenter := candidate.Min.Add(candidate.Max).Div(2)
+
+		// distance is the gap along the direction, offset the gap on the other axis
+		var distance, offset, centerOffset int
+		switch direction {
+		case DirectionUp, DirectionDown:
+			if (direction == DirectionUp) != (candidateCenter.Y < center.Y) || candidateCenter.Y == center.Y {
+				continue
+			}
+			distance = intervalGap(rect.Min.Y, rect.Max.Y, candidate.Min.Y, candidate.Max.Y)
+			offset = intervalGap(rect.Min.X, rect.Max.X, candidate.Min.X, candidate.Max.X)
+			centerOffset = AbsInt(candidateCenter.X - center.X)
+		case DirectionLeft, DirectionRight:
+			if (direction == DirectionLeft) != (candidateCenter.X < center.X) || candidateCenter.X == center.X {
+				continue
+			}
+			distance = intervalGap(rect.Min.X, rect.Max.X, candidate.Min.X, candidate.Max.X)
+			offset = intervalGap(rect.Min.Y, rect.Max.Y, candidate.Min.Y, candidate.Max.Y)
+			centerOffset = AbsInt(candidateCenter.Y - center.Y)
+		}
+
+		score := (distance+2*offset)*1000 + centerOffset
+		if best == -1 || score < bestScore {
+			best = i
+			bestScore = score
+		}
+	}
+
+	if best == -1 {
+		return false
+	}
+	self.setFocused(best)
+	return true
+}
+
+// intervalGap returns the distance between two intervals, or 0 if they overlap.
+func intervalGap(min0, max0, min1, max1 int) int {
+	return MaxInt(MaxInt(min1-max0, min0-max1), 0)
+}
+
+// HandleEvent moves the focus according to NextKey, PreviousKey and DirectionKeys.
+// It returns true if the event was consumed.
+func (self *FocusManager) HandleEvent(e Event) bool {
+	switch e.ID {
+	case "":
+		return false
+	case self.NextKey:
+		self.FocusNext()
+		return true
+	case self.PreviousKey:
+		self.FocusPrevious()
+		return true
+	}
+	if direction, ok := self.DirectionKeys[e.ID]; ok {
+		return self.FocusDirection(direction)
+	}
+	return false
+}
+
+// FocusAt moves the focus to the topmost widget containing the given point.
+func (self *FocusManager) FocusAt(p image.Point) bool {
+	for i := len(self.Widgets) - 1; i >= 0; i-- {
+		if p.In(self.Widgets[i].GetRect()) {
+			self.setFocused(i)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+package termui
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"os"
+	"strings"
+)
+
+// GraphicsProtocol is the escape sequence protocol used to draw bitmaps.
+type GraphicsProtocol uint
+
+const (
+	// GraphicsNone draws no bitmaps, widgets fall back to their cell rendering.
+	GraphicsNone GraphicsProtocol = iota
+	GraphicsSixel
+	GraphicsKitty
+	GraphicsITerm2
+)
+
+// Graphics is the protocol Render draws the bitmaps of a Buffer with, see AddBitmap.
+// It defaults to DetectGraphicsProtocol.
+var Graphics = DetectGraphicsProtocol()
+
+// CellPixelSize is the size of a cell in pixels, which is used to scale bitmaps if the
+// terminal doesn't report its size in pixels.
+var CellPixelSize = image.Pt(10, 20)
+
+// DetectGraphicsProtocol returns the graphics protocol the terminal is known to support,
+// based on the environment. Within tmux and screen no protocol is used, since they don't
+// keep track of bitmaps.
+func DetectGraphicsProtocol() GraphicsProtocol {
+	if DetectMultiplexer() != MultiplexerNone {
+		return GraphicsNone
+	}
+	term := os.Getenv("TERM")
+	switch {
+	case os.Getenv("KITTY_WINDOW_ID") != "", term == "xterm-kitty", term == "xterm-ghostty":
+		return GraphicsKitty
+	case strings.Contains(term, "sixel"), term == "foot", strings.HasPrefix(term, "mlterm"), strings.HasPrefix(term, "yaft"):
+		return GraphicsSixel
+	}
+	switch os.Getenv("TERM_PROGRAM") {
+	case "WezTerm", "ghostty":
+		return GraphicsKitty
+	case "iTerm.app":
+		return GraphicsITerm2
+	case "mintty":
+		return GraphicsSixel
+	}
+	return GraphicsNone
+}
+
+// Bitmap is an image that is drawn over the cells of Rect, scaled to fit while keeping
+// its aspect ratio.
+type Bitmap struct {
+	Image image.Image
+	Rect  image.Rectangle
+}
+
+// AddBitmap registers an image that Render draws over the cells of rect with the Graphics
+// protocol, after the cells have been drawn. The cells below should contain a fallback,
+// which is visible if there is no protocol or if a widget rendered later covers the bitmap.
+func (self *Buffer) AddBitmap(img image.Image, rect image.Rectangle) {
+	rect = rect.Intersect(self.Rectangle)
+	if Graphics == GraphicsNone || rect.Empty() {
+		return
+	}
+	self.Bitmaps = append(self.Bitmaps, Bitmap{img, rect})
+}
+
+// uncoveredBitmaps returns the bitmaps that don't intersect rect.
+func uncoveredBitmaps(bitmaps []Bitmap, rect image.Rectangle) []Bitmap {
+	uncovered := []Bitmap{}
+	for _, bitmap := range bitmaps {
+		if !bitmap.Rect.Overlaps(rect) {
+			uncovered = append(uncovered, bitmap)
+		}
+	}
+	return uncovered
+}
+
+// CellSize returns the size of a cell in pixels as reported by the terminal,
+// or CellPixelSize if it doesn't.
+func CellSize() image.Point {
+	columns, rows, width, height := terminalPixelSize()
+	if columns == 0 || rows == 0 || width == 0 || height == 0 {
+		return CellPixelSize
+	}
+	return image.Pt(width/columns, height/rows)
+}
+
+// writeOverlays draws what termbox can't draw after it flushed the cells: the hyperlinks
+// and the bitmaps. The redrawn rectangles are the areas whose cells were drawn since the last
+// call, which removes kitty images below them. The cursor and the attributes are saved and
+// restored around them, so that termbox keeps track of the terminal state.
+func writeOverlays(links []hyperlink, bitmaps []Bitmap, redrawn ...image.Rectangle) {
+	kittyPlacements.Lock()
+	defer kittyPlacements.Unlock()
+	if len(links) == 0 && len(bitmaps) == 0 && len(kittyPlacements.ids) == 0 {
+		return
+	}
+	var sb strings.Builder
+	sb.WriteString("\x1b7")
+	writeHyperlinks(&sb, links)
+	writeBitmaps(&sb, bitmaps, redrawn)
+	sb.WriteString("\x1b8")
+	fmt.Fprint(os.Stdout, sb.String())
+}
+
+// writeBitmaps draws the bitmaps with the Graphics protocol, after removing the kitty images
+// below the redrawn rectangles.
+func writeBitmaps(sb *strings.Builder, bitmaps []Bitmap, redrawn []image.Rectangle) {
+	safeArea := safeAreaRect()
+	cell := CellSize()
+	deleteKittyImages(sb, redrawn, bitmaps)
+	for _, bitmap := range bitmaps {
+		rect := bitmap.Rect.Intersect(safeArea)
+		if rect.Empty() {
+			continue
+		}
+		fmt.Fprintf(sb, "\x1b[%d;%dH", rect.Min.Y+SafeArea.Top+1, rect.Min.X+SafeArea.Left+1)
+		img := fitImage(bitmap.Image, image.Pt(rect.Dx()*cell.X, rect.Dy()*cell.Y))
+		switch Graphics {
+		case GraphicsSixel:
+			sb.WriteString(EncodeSixel(img))
+		case GraphicsKitty:
+			sb.WriteString(encodeKitty(img, kittyImageID(bitmap.Rect)))
+		case GraphicsITerm2:
+			sb.WriteString(encodeITerm2(img))
+		}
+	}
+}
+
+// fitImage scales img to the largest size that fits into size while keeping its aspect ratio.
+func fitImage(img image.Image, size image.Point) image.Image {
+	bounds := img.Bounds()
+	if bounds.Empty() || size.X <= 0 || size.Y <= 0 {
+		return image.NewRGBA(image.Rect(0, 0, 0, 0))
+	}
+	scale := MinFloat64(float64(size.X)/float64(bounds.Dx()), float64(size.Y)/float64(bounds.Dy()))
+	width := MaxInt(int(float64(bounds.Dx())*scale), 1)
+	height := MaxInt(int(float64(bounds.Dy())*scale), 1)
+	scaled := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			scaled.Set(x, y, color.RGBAModel.Convert(img.At(
+				bounds.Min.X+x*bounds.Dx()/width,
+				bounds.Min.Y+y*bounds.Dy()/height,
+			)))
+		}
+	}
+	return scaled
+}
@@ -0,0 +1,22 @@
+package termui
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/png"
+)
+
+// encodeITerm2 returns the iTerm2 inline image sequence that displays img at the cursor position.
+func encodeITerm2(img image.Image) string {
+	var data bytes.Buffer
+	if err := png.Encode(&data, img); err != nil {
+		return ""
+	}
+	bounds := img.Bounds()
+	return fmt.Sprintf(
+		"\x1b]1337;File=inline=1;size=%d;width=%dpx;height=%dpx;preserveAspectRatio=1;doNotMoveCursor=1:%s\a",
+		data.Len(), bounds.Dx(), bounds.Dy(), base64.StdEncoding.EncodeToString(data.Bytes()),
+	)
+}
@@ -0,0 +1,85 @@
+package termui
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/png"
+	"strings"
+	"sync"
+)
+
+// kittyChunkSize is the maximum size of the base64 data of a single kitty graphics sequence.
+const kittyChunkSize = 4096
+
+// kittyPlacements holds the ids of the images placed with the kitty graphics protocol by
+// their cells. Unlike sixels and iTerm2 images, kitty images are drawn above the text,
+// so they have to be deleted when their cells are redrawn.
+var kittyPlacements struct {
+	sync.Mutex
+	ids    map[image.Rectangle]uint32
+	nextID uint32
+}
+
+// kittyImageID returns the id of the image placed at rect, reusing the id of a previous
+// image at the same cells, so that it is replaced.
+func kittyImageID(rect image.Rectangle) uint32 {
+	if kittyPlacements.ids == nil {
+		kittyPlacements.ids = make(map[image.Rectangle]uint32)
+	}
+	id, ok := kittyPlacements.ids[rect]
+	if !ok {
+		kittyPlacements.nextID++
+		id = kittyPlacements.nextID
+		kittyPlacements.ids[rect] = id
+	}
+	return id
+}
+
+// deleteKittyImages writes the sequences that delete the placed images overlapping any of
+// the redrawn rectangles, except those at the cells of keep, which are replaced instead.
+func deleteKittyImages(sb *strings.Builder, redrawn []image.Rectangle, keep []Bitmap) {
+	kept := make(map[image.Rectangle]bool)
+	for _, bitmap := range keep {
+		kept[bitmap.Rect] = true
+	}
+	for rect, id := range kittyPlacements.ids {
+		if kept[rect] {
+			continue
+		}
+		for _, r := range redrawn {
+			if rect.Overlaps(r) {
+				fmt.Fprintf(sb, "\x1b_Ga=d,d=I,i=%d,q=2\x1b\\", id)
+				delete(kittyPlacements.ids, rect)
+				break
+			}
+		}
+	}
+}
+
+// encodeKitty returns the kitty graphics sequences that transmit img as PNG and display it
+// at the cursor position without moving the cursor.
+func encodeKitty(img image.Image, id uint32) string {
+	var data bytes.Buffer
+	if err := png.Encode(&data, img); err != nil {
+		return ""
+	}
+	encoded := base64.StdEncoding.EncodeToString(data.Bytes())
+
+	var sb strings.Builder
+	for first := true; first || len(encoded) > 0; first = false {
+		chunk := encoded[:MinInt(len(encoded), kittyChunkSize)]
+		encoded = encoded[len(chunk):]
+		more := 0
+		if len(encoded) > 0 {
+			more = 1
+		}
+		if first {
+			fmt.Fprintf(&sb, "\x1b_Ga=T,f=100,i=%d,C=1,q=2,m=%d;%s\x1b\\", id, more, chunk)
+		} else {
+			fmt.Fprintf(&sb, "\x1b_Gm=%d;%s\x1b\\", more, chunk)
+		}
+	}
+	return sb.String()
+}
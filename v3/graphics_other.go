@@ -0,0 +1,20 @@
+// +build !windows
+
+package termui
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// terminalPixelSize returns the size of the terminal in cells and in pixels,
+// which is 0 if it is unknown.
+func terminalPixelSize() (columns, rows, width, height int) {
+	var size struct {
+		rows, columns, width, height uint16
+	}
+	_, _, _ = syscall.Syscall(syscall.SYS_IOCTL,
+		os.Stdout.Fd(), uintptr(syscall.TIOCGWINSZ), uintptr(unsafe.Pointer(&size)))
+	return int(size.columns), int(size.rows), int(size.width), int(size.height)
+}
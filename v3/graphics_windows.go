@@ -0,0 +1,9 @@
+// +build windows
+
+package termui
+
+// terminalPixelSize returns the size of the terminal in cells and in pixels,
+// which is 0 if it is unknown.
+func terminalPixelSize() (columns, rows, width, height int) {
+	return 0, 0, 0, 0
+}
@@ -4,6 +4,10 @@
 
 package termui
 
+import (
+	"image"
+)
+
 type gridItemType uint
 
 const (
@@ -14,6 +18,10 @@ const (
 type Grid struct {
 	Block
 	Items []*GridItem
+	// Gap is the number of empty cells between the items and, if Border is set, the border.
+	// If Border is set without a gap, the borders of neighboring items and the grid overlap
+	// and are joined, e.g. with '┬' where an item's border meets the grid's border.
+	Gap int
 }
 
 // GridItem represents either a Row or Column in a grid.
@@ -133,28 +141,60 @@ func (self *Grid) setHelper(item GridItem, parentWidthRatio, parentHeightRatio f
 }
 
 func (self *Grid) Draw(buf *Buffer) {
-	width := float64(self.Dx()) + 1
-	height := float64(self.Dy()) + 1
+	area := self.Rectangle
+	if self.Border {
+		self.Block.Draw(buf)
+		if self.Gap > 0 {
+			area = self.Inner.Inset(self.Gap)
+		}
+	}
 
+	width := float64(area.Dx()) + 1
+	height := float64(area.Dy()) + 1
+
+	rects := []image.Rectangle{self.Rectangle}
 	for _, item := range self.Items {
 		entry, _ := item.Entry.(Drawable)
 
-		x := int(width*item.XRatio) + self.Min.X
-		y := int(height*item.YRatio) + self.Min.Y
+		x := int(width*item.XRatio) + area.Min.X
+		y := int(height*item.YRatio) + area.Min.Y
 		w := int(width * item.WidthRatio)
 		h := int(height * item.HeightRatio)
 
-		if x+w > self.Dx() {
+		if x+w > area.Max.X {
 			w--
 		}
-		if y+h > self.Dy() {
+		if y+h > area.Max.Y {
 			h--
 		}
 
+		// leave the gap to the next item, or let the borders of both items overlap
+		if x+w < area.Max.X {
+			w = self.adjustForGap(w)
+		}
+		if y+h < area.Max.Y {
+			h = self.adjustForGap(h)
+		}
+
 		entry.SetRect(x, y, x+w, y+h)
+		rects = append(rects, entry.GetRect())
 
 		entry.Lock()
 		entry.Draw(buf)
 		entry.Unlock()
 	}
+
+	if self.Border && self.Gap == 0 {
+		buf.MergeJunctions(rects...)
+	}
+}
+
+func (self *Grid) adjustForGap(size int) int {
+	switch {
+	case self.Gap > 0:
+		return MaxInt(0, size-self.Gap)
+	case self.Border:
+		return size + 1
+	}
+	return size
 }
@@ -0,0 +1,78 @@
+package termui
+
+import (
+	"image"
+	"sync"
+)
+
+// HitRegion is an interactive area of a widget, e.g. a header cell or a tab, which widgets
+// register while drawing so that apps can find out what was clicked without computing layouts.
+type HitRegion struct {
+	Widget  Drawable
+	Name    string
+	Rect    image.Rectangle
+	Payload interface{}
+}
+
+// AddHitRegion registers an interactive area of widget. The regions of a buffer
+// are collected by Render and can be looked up with HitRegionAt.
+func (self *Buffer) AddHitRegion(widget Drawable, name string, rect image.Rectangle, payload interface{}) {
+	rect = rect.Intersect(self.Rectangle)
+	if rect.Empty() {
+		return
+	}
+	self.HitRegions = append(self.HitRegions, HitRegion{widget, name, rect, payload})
+}
+
+// hitRegions holds the regions of the last Render of each item, in render order.
+var hitRegions struct {
+	sync.Mutex
+	items   []Drawable
+	regions map[Drawable][]HitRegion
+}
+
+// setHitRegions replaces the regions of a rendered item and moves it on top.
+func setHitRegions(item Drawable, regions []HitRegion) {
+	hitRegions.Lock()
+	defer hitRegions.Unlock()
+	if hitRegions.regions == nil {
+		hitRegions.regions = make(map[Drawable][]HitRegion)
+	}
+	for i, other := range hitRegions.items {
+		if other == item {
+			hitRegions.items = append(hitRegions.items[:i], hitRegions.items[i+1:]...)
+			break
+		}
+	}
+	if len(regions) == 0 {
+		delete(hitRegions.regions, item)
+		return
+	}
+	hitRegions.items = append(hitRegions.items, item)
+	hitRegions.regions[item] = regions
+}
+
+// HitRegionAt returns the topmost region at the given point, preferring the most recently
+// rendered items and, within an item, the regions registered last.
+func HitRegionAt(p image.Point) (HitRegion, bool) {
+	hitRegions.Lock()
+	defer hitRegions.Unlock()
+	for i := len(hitRegions.items) - 1; i >= 0; i-- {
+		regions := hitRegions.regions[hitRegions.items[i]]
+		for j := len(regions) - 1; j >= 0; j-- {
+			if p.In(regions[j].Rect) {
+				return regions[j], true
+			}
+		}
+	}
+	return HitRegion{}, false
+}
+
+// ResolveHitRegion returns the region clicked by a mouse event.
+func ResolveHitRegion(e Event) (HitRegion, bool) {
+	mouse, ok := e.Payload.(Mouse)
+	if e.Type != MouseEvent || !ok {
+		return HitRegion{}, false
+	}
+	return HitRegionAt(image.Pt(mouse.X, mouse.Y))
+}
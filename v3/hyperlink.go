@@ -0,0 +1,196 @@
+package termui
+
+import (
+	"fmt"
+	"image"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	rw "github.com/mattn/go-runewidth"
+)
+
+// EmitHyperlinks makes Render turn the linked cells of a Buffer into OSC 8 hyperlinks, see SetLink.
+// It defaults to SupportsHyperlinks. Otherwise the cells are drawn with Theme.Hyperlink.
+var EmitHyperlinks = SupportsHyperlinks()
+
+// SupportsHyperlinks reports whether the terminal is known to support OSC 8 hyperlinks,
+// based on the environment. screen doesn't support them.
+func SupportsHyperlinks() bool {
+	if DetectMultiplexer() == MultiplexerScreen {
+		return false
+	}
+	switch os.Getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "vscode", "Hyper", "ghostty":
+		return true
+	}
+	if version, err := strconv.Atoi(os.Getenv("VTE_VERSION")); err == nil && version >= 5000 {
+		return true
+	}
+	return os.Getenv("KITTY_WINDOW_ID") != "" || os.Getenv("WT_SESSION") != "" ||
+		os.Getenv("TERM") == "foot" || os.Getenv("TERM") == "xterm-kitty"
+}
+
+// Link is a text that links to a URL, as returned by ParseStyleLinks and ParseANSILinks.
+type Link struct {
+	Text string
+	URL  string
+}
+
+// SetLink turns the cells of rect into a hyperlink to url, see EmitHyperlinks.
+func (self *Buffer) SetLink(url string, rect image.Rectangle) {
+	if self.Links == nil {
+		self.Links = make(map[image.Point]string)
+	}
+	rect = rect.Intersect(self.Rectangle)
+	for x := rect.Min.X; x < rect.Max.X; x++ {
+		for y := rect.Min.Y; y < rect.Max.Y; y++ {
+			self.Links[image.Pt(x, y)] = url
+		}
+	}
+}
+
+// SetLinks turns every occurrence of the link texts within a line of rect into a hyperlink,
+// e.g. after drawing text that links were parsed from. Longer texts take precedence over
+// the texts they contain. Texts that are wrapped across lines aren't linked.
+func (self *Buffer) SetLinks(links []Link, rect image.Rectangle) {
+	links = append([]Link{}, links...)
+	sort.SliceStable(links, func(i, j int) bool {
+		return len(links[i].Text) > len(links[j].Text)
+	})
+	rect = rect.Intersect(self.Rectangle)
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		runes := []rune{}
+		xs := []int{}
+		for x := rect.Min.X; x < rect.Max.X; {
+			cell := self.GetCell(image.Pt(x, y))
+			runes = append(runes, cell.Rune)
+			xs = append(xs, x)
+			x += MaxInt(rw.RuneWidth(cell.Rune), 1)
+		}
+		xs = append(xs, rect.Max.X)
+		linked := make([]bool, len(runes))
+		for _, link := range links {
+			text := []rune(link.Text)
+			if len(text) == 0 || link.URL == "" {
+				continue
+			}
+			for i := 0; i+len(text) <= len(runes); i++ {
+				if string(runes[i:i+len(text)]) != link.Text || linked[i] || linked[i+len(text)-1] {
+					continue
+				}
+				self.SetLink(link.URL, image.Rect(xs[i], y, xs[i+len(text)], y+1))
+				for k := i; k < i+len(text); k++ {
+					linked[k] = true
+				}
+				i += len(text) - 1
+			}
+		}
+	}
+}
+
+// hyperlinkStyle returns the style a linked cell is drawn with if hyperlinks aren't emitted.
+func hyperlinkStyle(style Style) Style {
+	if EmitHyperlinks {
+		return style
+	}
+	if Theme.Hyperlink.Fg != ColorClear {
+		style.Fg = Theme.Hyperlink.Fg
+	}
+	style.Modifier |= Theme.Hyperlink.Modifier
+	return style
+}
+
+// hyperlink is a run of adjacent cells in a row that link to the same URL.
+type hyperlink struct {
+	Point image.Point
+	URL   string
+	Cells []Cell
+}
+
+// bufferHyperlinks returns the hyperlinks of the visible part of buf.
+func bufferHyperlinks(buf *Buffer) []hyperlink {
+	links := []hyperlink{}
+	if !EmitHyperlinks || len(buf.Links) == 0 {
+		return links
+	}
+	area := buf.Rectangle.Intersect(safeAreaRect())
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		var link *hyperlink
+		for x := area.Min.X; x < area.Max.X; {
+			cell := buf.GetCell(image.Pt(x, y))
+			url := buf.Links[image.Pt(x, y)]
+			switch {
+			case url == "":
+				link = nil
+			case link != nil && link.URL == url:
+				link.Cells = append(link.Cells, cell)
+			default:
+				links = append(links, hyperlink{image.Pt(x, y), url, []Cell{cell}})
+				link = &links[len(links)-1]
+			}
+			x += MaxInt(rw.RuneWidth(cell.Rune), 1)
+		}
+	}
+	return links
+}
+
+// uncoveredHyperlinks returns the hyperlinks that don't intersect rect.
+func uncoveredHyperlinks(links []hyperlink, rect image.Rectangle) []hyperlink {
+	uncovered := []hyperlink{}
+	for _, link := range links {
+		width := 0
+		for _, cell := range link.Cells {
+			width += MaxInt(rw.RuneWidth(cell.Rune), 1)
+		}
+		if !image.Rect(link.Point.X, link.Point.Y, link.Point.X+width, link.Point.Y+1).Overlaps(rect) {
+			uncovered = append(uncovered, link)
+		}
+	}
+	return uncovered
+}
+
+// writeHyperlinks redraws the cells of the hyperlinks wrapped in OSC 8 sequences.
+func writeHyperlinks(sb *strings.Builder, links []hyperlink) {
+	for _, link := range links {
+		fmt.Fprintf(sb, "\x1b[%d;%dH", link.Point.Y+SafeArea.Top+1, link.Point.X+SafeArea.Left+1)
+		sb.WriteString("\x1b]8;;" + sanitizeURL(link.URL) + "\x1b\\")
+		for _, cell := range link.Cells {
+			sb.WriteString(styleToSGR(EnforceContrast(cell.Style, Theme.MinContrast)))
+			sb.WriteRune(cell.Rune)
+		}
+		sb.WriteString("\x1b]8;;\x1b\\")
+	}
+}
+
+// sanitizeURL removes control characters, which could end the OSC 8 sequence early.
+func sanitizeURL(url string) string {
+	return strings.Map(func(r rune) rune {
+		if r < ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, url)
+}
+
+// styleToSGR returns the SGR sequence that sets the colors and modifiers of style.
+func styleToSGR(style Style) string {
+	params := []string{"0"}
+	if style.Modifier&ModifierBold != 0 {
+		params = append(params, "1")
+	}
+	if style.Modifier&ModifierUnderline != 0 {
+		params = append(params, "4")
+	}
+	if style.Modifier&ModifierReverse != 0 {
+		params = append(params, "7")
+	}
+	if style.Fg != ColorClear {
+		params = append(params, "38;5;"+strconv.Itoa(int(style.Fg)))
+	}
+	if style.Bg != ColorClear {
+		params = append(params, "48;5;"+strconv.Itoa(int(style.Bg)))
+	}
+	return "\x1b[" + strings.Join(params, ";") + "m"
+}
@@ -0,0 +1,178 @@
+package termui
+
+import (
+	"fmt"
+	"image"
+	"strings"
+)
+
+// Container is implemented by widgets that lay out other widgets, like Grid,
+// so that tools like the Inspector can walk the widget tree.
+type Container interface {
+	Children() []Drawable
+}
+
+// Children returns the widgets of the grid.
+func (self *Grid) Children() []Drawable {
+	children := make([]Drawable, 0, len(self.Items))
+	for _, item := range self.Items {
+		if entry, ok := item.Entry.(Drawable); ok {
+			children = append(children, entry)
+		}
+	}
+	return children
+}
+
+// InspectorNode is a widget in the tree shown by the Inspector.
+type InspectorNode struct {
+	Widget  Drawable
+	Depth   int
+	Visible bool
+}
+
+// Inspector is a developer overlay for debugging layouts. It draws the Roots and on top of them
+// a panel listing the widget tree with the type, ID, rectangle, visibility and focus of each widget.
+// The selected widget is highlighted on screen. The panel is placed with SetRect, and the
+// Inspector is rendered instead of the Roots while it is open.
+type Inspector struct {
+	Block
+	Roots []Drawable
+	// IDs optionally names the widgets.
+	IDs map[Drawable]string
+	// Focus is used to mark the focused widget, if set.
+	Focus *FocusManager
+
+	TextStyle        Style
+	SelectedRowStyle Style
+	HighlightStyle   Style
+	SelectedRow      int
+
+	topRow int
+}
+
+func NewInspector(roots ...Drawable) *Inspector {
+	inspector := &Inspector{
+		Block:            *NewBlock(),
+		Roots:            roots,
+		IDs:              make(map[Drawable]string),
+		TextStyle:        Theme.Inspector.Text,
+		SelectedRowStyle: Theme.Inspector.Selected,
+		HighlightStyle:   Theme.Inspector.Highlight,
+	}
+	inspector.Title = "Inspector"
+	return inspector
+}
+
+// GetRect returns the area covered by the panel and all Roots, which is drawn by the Inspector.
+func (self *Inspector) GetRect() image.Rectangle {
+	rect := self.Block.GetRect()
+	for _, root := range self.Roots {
+		rect = rect.Union(root.GetRect())
+	}
+	return rect
+}
+
+// Nodes returns the widget tree in depth first order.
+func (self *Inspector) Nodes() []InspectorNode {
+	nodes := []InspectorNode{}
+	var walk func(widget Drawable, depth int, parent image.Rectangle, parentVisible bool)
+	walk = func(widget Drawable, depth int, parent image.Rectangle, parentVisible bool) {
+		rect := widget.GetRect()
+		visible := parentVisible && !rect.Empty() && rect.Overlaps(parent)
+		nodes = append(nodes, InspectorNode{widget, depth, visible})
+		if container, ok := widget.(Container); ok {
+			for _, child := range container.Children() {
+				walk(child, depth+1, rect, visible)
+			}
+		}
+	}
+	for _, root := range self.Roots {
+		walk(root, 0, root.GetRect(), true)
+	}
+	return nodes
+}
+
+// Selected returns the selected widget or nil if there are no widgets.
+func (self *Inspector) Selected() Drawable {
+	nodes := self.Nodes()
+	if self.SelectedRow < 0 || self.SelectedRow >= len(nodes) {
+		return nil
+	}
+	return nodes[self.SelectedRow].Widget
+}
+
+// ScrollAmount moves the selection by amount given. If amount is < 0, then move up.
+func (self *Inspector) ScrollAmount(amount int) {
+	self.SelectedRow = MaxInt(MinInt(self.SelectedRow+amount, len(self.Nodes())-1), 0)
+}
+
+func (self *Inspector) ScrollUp() {
+	self.ScrollAmount(-1)
+}
+
+func (self *Inspector) ScrollDown() {
+	self.ScrollAmount(1)
+}
+
+// describe returns the line shown for a node, e.g. "*widgets.Paragraph #log (0,0)-(40,10) 40x10 focused".
+func (self *Inspector) describe(node InspectorNode) string {
+	rect := node.Widget.GetRect()
+	line := strings.Repeat("  ", node.Depth) + fmt.Sprintf("%T", node.Widget)
+	if id, ok := self.IDs[node.Widget]; ok {
+		line += " #" + id
+	}
+	line += fmt.Sprintf(" %v %dx%d", rect, rect.Dx(), rect.Dy())
+	if !node.Visible {
+		line += " hidden"
+	}
+	if self.Focus != nil && self.Focus.Focused() == node.Widget {
+		line += " focused"
+	}
+	return line
+}
+
+func (self *Inspector) Draw(buf *Buffer) {
+	for _, root := range self.Roots {
+		root.Lock()
+		root.Draw(buf)
+		root.Unlock()
+	}
+
+	nodes := self.Nodes()
+	self.SelectedRow = MaxInt(MinInt(self.SelectedRow, len(nodes)-1), 0)
+
+	// highlight the outline of the selected widget
+	if len(nodes) > 0 {
+		rect := nodes[self.SelectedRow].Widget.GetRect().Intersect(buf.Rectangle)
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			for y := rect.Min.Y; y < rect.Max.Y; y++ {
+				if x == rect.Min.X || x == rect.Max.X-1 || y == rect.Min.Y || y == rect.Max.Y-1 {
+					cell := buf.GetCell(image.Pt(x, y))
+					cell.Style = self.HighlightStyle
+					buf.SetCell(cell, image.Pt(x, y))
+				}
+			}
+		}
+	}
+
+	// draw the panel
+	buf.Fill(CellClear, self.Block.Rectangle)
+	self.Block.Draw(buf)
+
+	// adjust the view to the selected row
+	if self.SelectedRow >= self.Inner.Dy()+self.topRow {
+		self.topRow = self.SelectedRow - self.Inner.Dy() + 1
+	} else if self.SelectedRow < self.topRow {
+		self.topRow = self.SelectedRow
+	}
+
+	for row, y := self.topRow, self.Inner.Min.Y; row < len(nodes) && y < self.Inner.Max.Y; row, y = row+1, y+1 {
+		style := self.TextStyle
+		if row == self.SelectedRow {
+			style = self.SelectedRowStyle
+			buf.Fill(NewCell(' ', style), image.Rect(self.Inner.Min.X, y, self.Inner.Max.X, y+1))
+		}
+		line := TrimString(self.describe(nodes[row]), self.Inner.Dx())
+		buf.SetString(line, style, image.Pt(self.Inner.Min.X, y))
+	}
+}
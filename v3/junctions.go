@@ -0,0 +1,100 @@
+package termui
+
+import (
+	"image"
+)
+
+const (
+	lineUp = 1 << iota
+	lineDown
+	lineLeft
+	lineRight
+)
+
+// boxRunes maps the box drawing runes to the directions they connect to.
+// It is a slice rather than a map, since the runes aren't unique on all platforms.
+var boxRunes = []struct {
+	rune
+	lines uint8
+}{
+	{VERTICAL_LINE, lineUp | lineDown},
+	{HORIZONTAL_LINE, lineLeft | lineRight},
+	{TOP_LEFT, lineDown | lineRight},
+	{TOP_RIGHT, lineDown | lineLeft},
+	{BOTTOM_LEFT, lineUp | lineRight},
+	{BOTTOM_RIGHT, lineUp | lineLeft},
+	{VERTICAL_LEFT, lineUp | lineDown | lineLeft},
+	{VERTICAL_RIGHT, lineUp | lineDown | lineRight},
+	{HORIZONTAL_UP, lineLeft | lineRight | lineUp},
+	{HORIZONTAL_DOWN, lineLeft | lineRight | lineDown},
+	{CROSS, lineUp | lineDown | lineLeft | lineRight},
+}
+
+func boxRuneLines(r rune) uint8 {
+	for _, box := range boxRunes {
+		if box.rune == r {
+			return box.lines
+		}
+	}
+	return 0
+}
+
+func boxRuneFromLines(lines uint8) rune {
+	for _, box := range boxRunes {
+		if box.lines == lines {
+			return box.rune
+		}
+	}
+	return 0
+}
+
+// MergeJunctions replaces the box drawing runes on the edges of the given rectangles
+// with junctions wherever they meet a line of a neighboring cell, e.g. a horizontal
+// border with a vertical border ending on it becomes '┬'.
+func (self *Buffer) MergeJunctions(rects ...image.Rectangle) {
+	merged := make(map[image.Point]rune)
+	merge := func(p image.Point) {
+		cell, ok := self.CellMap[p]
+		if !ok {
+			return
+		}
+		lines := boxRuneLines(cell.Rune)
+		if lines == 0 {
+			return
+		}
+		neighbors := []struct {
+			offset   image.Point
+			line     uint8
+			opposite uint8
+		}{
+			{image.Pt(0, -1), lineUp, lineDown},
+			{image.Pt(0, 1), lineDown, lineUp},
+			{image.Pt(-1, 0), lineLeft, lineRight},
+			{image.Pt(1, 0), lineRight, lineLeft},
+		}
+		for _, neighbor := range neighbors {
+			if boxRuneLines(self.CellMap[p.Add(neighbor.offset)].Rune)&neighbor.opposite != 0 {
+				lines |= neighbor.line
+			}
+		}
+		if r := boxRuneFromLines(lines); r != 0 {
+			merged[p] = r
+		}
+	}
+	for _, rect := range rects {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			merge(image.Pt(x, rect.Min.Y))
+			merge(image.Pt(x, rect.Max.Y-1))
+		}
+		for y := rect.Min.Y; y < rect.Max.Y; y++ {
+			merge(image.Pt(rect.Min.X, y))
+			merge(image.Pt(rect.Max.X-1, y))
+		}
+	}
+	// apply the merged runes afterwards, so that they don't affect each other
+	for p, r := range merged {
+		cell := self.CellMap[p]
+		cell.Rune = r
+		self.CellMap[p] = cell
+	}
+}
@@ -0,0 +1,116 @@
+package termui
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// Macros records sequences of keyboard events and replays them on demand.
+// Pressing RecordKey followed by a key starts recording a macro bound to that key,
+// pressing RecordKey again stops the recording. Pressing ReplayKey followed by the
+// key replays the macro. Mouse events aren't recorded, as they depend on the layout.
+//
+//	for e := range ui.PollEvents() {
+//		for _, e := range macros.HandleEvent(e) {
+//			// handle e as usual
+//		}
+//	}
+type Macros struct {
+	RecordKey string
+	ReplayKey string
+	// Bindings maps keys to the IDs of the recorded keyboard events.
+	Bindings map[string][]string
+
+	// pending is the RecordKey or ReplayKey waiting for the key of the macro.
+	pending   string
+	recording string
+	events    []string
+}
+
+func NewMacros() *Macros {
+	return &Macros{
+		RecordKey: "<F7>",
+		ReplayKey: "<F8>",
+		Bindings:  make(map[string][]string),
+	}
+}
+
+// IsRecording returns whether a macro is being recorded.
+func (self *Macros) IsRecording() bool {
+	return self.recording != ""
+}
+
+// StartRecording starts recording a macro bound to key, replacing an existing one.
+func (self *Macros) StartRecording(key string) {
+	self.recording = key
+	self.events = []string{}
+}
+
+// StopRecording stops recording and binds the recorded events.
+func (self *Macros) StopRecording() {
+	if self.IsRecording() {
+		self.Bindings[self.recording] = self.events
+	}
+	self.recording = ""
+	self.events = nil
+}
+
+// Replay returns the events of the macro bound to key, or nil if there is none.
+func (self *Macros) Replay(key string) []Event {
+	ids, ok := self.Bindings[key]
+	if !ok {
+		return nil
+	}
+	events := make([]Event, len(ids))
+	for i, id := range ids {
+		events[i] = Event{Type: KeyboardEvent, ID: id}
+	}
+	return events
+}
+
+// HandleEvent records the event if a macro is being recorded and returns the events
+// that should be handled by the app: the event itself, the events of a replayed macro,
+// or none if the event was used to control recording and replay.
+func (self *Macros) HandleEvent(e Event) []Event {
+	if e.Type != KeyboardEvent {
+		return []Event{e}
+	}
+
+	switch {
+	case self.pending == self.RecordKey && self.pending != "":
+		self.pending = ""
+		self.StartRecording(e.ID)
+		return nil
+	case self.pending == self.ReplayKey && self.pending != "":
+		self.pending = ""
+		return self.Replay(e.ID)
+	case e.ID == self.RecordKey && self.IsRecording():
+		self.StopRecording()
+		return nil
+	case e.ID == self.RecordKey || e.ID == self.ReplayKey:
+		self.pending = e.ID
+		return nil
+	}
+
+	if self.IsRecording() {
+		self.events = append(self.events, e.ID)
+	}
+	return []Event{e}
+}
+
+// Save writes the Bindings as JSON, so that macros can be kept between sessions.
+func (self *Macros) Save(w io.Writer) error {
+	return json.NewEncoder(w).Encode(self.Bindings)
+}
+
+// Load reads Bindings written by Save, adding them to the existing ones.
+func (self *Macros) Load(r io.Reader) error {
+	bindings := make(map[string][]string)
+	if err := json.NewDecoder(r).Decode(&bindings); err != nil {
+		return err
+	}
+	for key, ids := range bindings {
+		self.Bindings[key] = ids
+	}
+	return nil
+}
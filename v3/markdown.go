@@ -0,0 +1,135 @@
+package termui
+
+import (
+	"strings"
+	"unicode"
+)
+
+// MarkdownStyles are the styles of the markdown elements recognized by ParseMarkdown.
+type MarkdownStyles struct {
+	Heading Style
+	Code    Style
+	Quote   Style
+	Bullet  Style
+}
+
+const (
+	markdownFence  = "```"
+	markdownBullet = '•'
+	markdownQuote  = '│'
+)
+
+// ParseMarkdown parses a safe subset of markdown and returns []Cell with the matching styles.
+// Uses defaultStyle for plain text. Supported are headings (`# `), block quotes (`> `),
+// lists (`- `, `* `, `+ `, `1. `), fenced code blocks, code spans (`code`), bold
+// (**bold**, __bold__) and italic (*italic*, _italic_) text. Italic text is underlined,
+// since terminals don't support italics reliably. A backslash escapes the next rune,
+// everything else is shown as is.
+func ParseMarkdown(s string, defaultStyle Style, styles MarkdownStyles) []Cell {
+	cells := []Cell{}
+	inCodeBlock, first := false, true
+	for _, line := range strings.Split(s, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), markdownFence) {
+			inCodeBlock = !inCodeBlock
+			continue
+		}
+		if !first {
+			cells = append(cells, Cell{'\n', defaultStyle})
+		}
+		first = false
+		if inCodeBlock {
+			cells = append(cells, RunesToStyledCells([]rune(line), styles.Code)...)
+			continue
+		}
+		cells = append(cells, parseMarkdownLine(line, defaultStyle, styles)...)
+	}
+	return cells
+}
+
+// parseMarkdownLine parses the block element of a single line.
+func parseMarkdownLine(line string, defaultStyle Style, styles MarkdownStyles) []Cell {
+	trimmed := strings.TrimLeft(line, " ")
+	indent := RunesToStyledCells([]rune(line[:len(line)-len(trimmed)]), defaultStyle)
+
+	if level := len(trimmed) - len(strings.TrimLeft(trimmed, "#")); level > 0 && level <= 6 &&
+		strings.HasPrefix(trimmed[level:], " ") {
+		return parseMarkdownInline([]rune(strings.TrimSpace(trimmed[level:])), styles.Heading, styles)
+	}
+
+	if strings.HasPrefix(trimmed, ">") {
+		text := strings.TrimPrefix(strings.TrimPrefix(trimmed, ">"), " ")
+		cells := append(indent, Cell{markdownQuote, styles.Quote}, Cell{' ', styles.Quote})
+		return append(cells, parseMarkdownInline([]rune(text), styles.Quote, styles)...)
+	}
+
+	for _, marker := range []string{"- ", "* ", "+ "} {
+		if strings.HasPrefix(trimmed, marker) {
+			cells := append(indent, Cell{markdownBullet, styles.Bullet}, Cell{' ', defaultStyle})
+			return append(cells, parseMarkdownInline([]rune(trimmed[len(marker):]), defaultStyle, styles)...)
+		}
+	}
+
+	if digits := len(trimmed) - len(strings.TrimLeft(trimmed, "0123456789")); digits > 0 &&
+		strings.HasPrefix(trimmed[digits:], ". ") {
+		cells := append(indent, RunesToStyledCells([]rune(trimmed[:digits+1]), styles.Bullet)...)
+		cells = append(cells, Cell{' ', defaultStyle})
+		return append(cells, parseMarkdownInline([]rune(trimmed[digits+2:]), defaultStyle, styles)...)
+	}
+
+	return parseMarkdownInline([]rune(line), defaultStyle, styles)
+}
+
+// parseMarkdownInline parses code spans and emphasis. Unclosed markers are kept as text.
+func parseMarkdownInline(runes []rune, style Style, styles MarkdownStyles) []Cell {
+	cells := []Cell{}
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\\' && i+1 < len(runes):
+			i++
+			cells = append(cells, Cell{runes[i], style})
+			continue
+		case r == '`':
+			if end := indexRunes(runes, i+1, "`"); end > i+1 {
+				cells = append(cells, RunesToStyledCells(runes[i+1:end], styles.Code)...)
+				i = end
+				continue
+			}
+		case r == '*' || r == '_':
+			// underscores within words, like snake_case, aren't emphasis
+			if r == '_' && i > 0 && isWordRune(runes[i-1]) {
+				break
+			}
+			marker, emphasis := string(r), ModifierUnderline
+			if i+1 < len(runes) && runes[i+1] == r {
+				marker, emphasis = string([]rune{r, r}), ModifierBold
+			}
+			start := i + len(marker)
+			if end := indexRunes(runes, start, marker); end > start && runes[start] != ' ' {
+				emphasized := style
+				emphasized.Modifier |= emphasis
+				cells = append(cells, parseMarkdownInline(runes[start:end], emphasized, styles)...)
+				i = end + len(marker) - 1
+				continue
+			}
+		}
+		cells = append(cells, Cell{r, style})
+	}
+	return cells
+}
+
+// indexRunes returns the index of the first occurrence of marker in runes at or after start,
+// or -1 if there is none.
+func indexRunes(runes []rune, start int, marker string) int {
+	if start > len(runes) {
+		return -1
+	}
+	if index := strings.Index(string(runes[start:]), marker); index >= 0 {
+		return start + len([]rune(string(runes[start:])[:index]))
+	}
+	return -1
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,82 @@
+package termui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+type Multiplexer uint
+
+const (
+	MultiplexerNone Multiplexer = iota
+	MultiplexerTmux
+	MultiplexerScreen
+)
+
+// screenPassthroughLimit is the maximum length of a string screen passes through at once.
+const screenPassthroughLimit = 768
+
+// DetectMultiplexer returns the terminal multiplexer the app is running in, based on the environment.
+func DetectMultiplexer() Multiplexer {
+	switch {
+	case os.Getenv("TMUX") != "":
+		return MultiplexerTmux
+	case os.Getenv("STY") != "" || strings.HasPrefix(os.Getenv("TERM"), "screen"):
+		return MultiplexerScreen
+	}
+	return MultiplexerNone
+}
+
+// WrapPassthrough wraps an escape sequence, e.g. an OSC or graphics sequence, so that tmux or
+// screen pass it through to the outer terminal instead of interpreting or dropping it.
+// Outside of a multiplexer the sequence is returned unchanged.
+// tmux only passes sequences through with the allow-passthrough option turned on.
+func WrapPassthrough(seq string) string {
+	switch DetectMultiplexer() {
+	case MultiplexerTmux:
+		return "\x1bPtmux;" + strings.Replace(seq, "\x1b", "\x1b\x1b", -1) + "\x1b\\"
+	case MultiplexerScreen:
+		// screen limits the length of a passthrough string, so longer sequences are split up
+		var sb strings.Builder
+		for len(seq) > 0 {
+			n := MinInt(len(seq), screenPassthroughLimit)
+			sb.WriteString("\x1bP" + seq[:n] + "\x1b\\")
+			seq = seq[n:]
+		}
+		return sb.String()
+	}
+	return seq
+}
+
+// WriteEscapeSequence writes an escape sequence to the terminal, wrapped with WrapPassthrough.
+func WriteEscapeSequence(seq string) error {
+	_, err := fmt.Fprint(os.Stdout, WrapPassthrough(seq))
+	return err
+}
+
+// SupportsTrueColor reports whether the terminal advertises 24-bit colors via COLORTERM.
+// screen never supports them, and tmux only if its terminal-overrides add the Tc flag,
+// in which case it forwards COLORTERM.
+func SupportsTrueColor() bool {
+	if DetectMultiplexer() == MultiplexerScreen {
+		return false
+	}
+	colorTerm := os.Getenv("COLORTERM")
+	return colorTerm == "truecolor" || colorTerm == "24bit"
+}
+
+// EnableFocusReporting asks the terminal to report when it gains or loses focus as <FocusGained>
+// and <FocusLost> events, so that apps can pause refreshing while their window or pane isn't focused.
+// Within tmux this requires the focus-events option to be turned on.
+// The sequence is handled by the multiplexer itself and therefore not passed through.
+func EnableFocusReporting() error {
+	_, err := fmt.Fprint(os.Stdout, "\x1b[?1004h")
+	return err
+}
+
+// DisableFocusReporting turns focus reporting off again and should be called before Close.
+func DisableFocusReporting() error {
+	_, err := fmt.Fprint(os.Stdout, "\x1b[?1004l")
+	return err
+}
@@ -0,0 +1,213 @@
+// Package remote provides an optional HTTP endpoint for inspecting and driving a running dashboard,
+// e.g. from CI or a companion web page.
+//
+//	GET  /screenshot                  the Roots rendered as text, see termui.RenderText
+//	GET  /actions/{widget}            the actions of a widget as JSON array
+//	POST /actions/{widget}/{action}   performs an action, with an optional JSON array of arguments as body
+//	POST /data/{binding}              pushes the JSON number in the body to a PlotBinding
+package remote
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ui "github.com/s-westphal/termui/v3"
+	"github.com/s-westphal/termui/v3/widgets"
+)
+
+// maxBodySize limits the size of request bodies, which only hold a few JSON values.
+const maxBodySize = 1 << 16
+
+// Server serves the endpoint described in the package documentation for a running dashboard.
+type Server struct {
+	// Roots are the widgets shown on screen, in the order they are rendered.
+	// Screenshots draw them on the HTTP goroutine, so RenderLock should be the lock the app
+	// holds while rendering. Without it, the app must not change the Roots themselves,
+	// e.g. replace the content of a Grid, while the server is running.
+	Roots []ui.Drawable
+	// RenderLock is held while taking a screenshot if set.
+	RenderLock sync.Locker
+	// Widgets are the widgets that can be driven by name. They must implement ui.Actionable.
+	Widgets map[string]ui.Drawable
+	// Bindings are the plot bindings data can be pushed to by name.
+	Bindings map[string]*widgets.PlotBinding
+	// Token is required as bearer token in the Authorization header, if set.
+	// ListenAndServe refuses addresses other than loopback addresses without one.
+	Token string
+	// Timeout limits reading a request and writing its response in ListenAndServe.
+	Timeout time.Duration
+	// OnChange is called after an action was performed or data was pushed, e.g. to render again.
+	// It is called without the widgets locked, so that it can render, but never concurrently.
+	OnChange func()
+
+	changeMu sync.Mutex
+}
+
+// ErrNoToken is returned by ListenAndServe for an address other than a loopback address
+// if no Token is set.
+var ErrNoToken = errors.New("remote: a token is required to listen on a non-loopback address")
+
+func NewServer(roots ...ui.Drawable) *Server {
+	return &Server{
+		Roots:    roots,
+		Widgets:  make(map[string]ui.Drawable),
+		Bindings: make(map[string]*widgets.PlotBinding),
+		Timeout:  10 * time.Second,
+	}
+}
+
+// ListenAndServe serves the endpoint on addr, which should usually be a loopback address like "localhost:8080".
+// Other addresses require a Token.
+func (self *Server) ListenAndServe(addr string) error {
+	if self.Token == "" && !isLoopback(addr) {
+		return ErrNoToken
+	}
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           self,
+		ReadHeaderTimeout: self.Timeout,
+		ReadTimeout:       self.Timeout,
+		WriteTimeout:      self.Timeout,
+		IdleTimeout:       self.Timeout,
+	}
+	return server.ListenAndServe()
+}
+
+// isLoopback reports whether the host of addr is localhost or a loopback IP address.
+// An empty host listens on all interfaces.
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func (self *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	authorization := []byte(r.Header.Get("Authorization"))
+	if self.Token != "" && subtle.ConstantTimeCompare(authorization, []byte("Bearer "+self.Token)) != 1 {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case len(path) == 1 && path[0] == "screenshot" && r.Method == http.MethodGet:
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		fmt.Fprint(w, self.screenshot())
+	case len(path) == 2 && path[0] == "actions" && r.Method == http.MethodGet:
+		widget, ok := self.actionable(w, path[1])
+		if !ok {
+			return
+		}
+		widget.(ui.Drawable).Lock()
+		actions := widget.Actions()
+		widget.(ui.Drawable).Unlock()
+		writeJSON(w, actions)
+	case len(path) == 3 && path[0] == "actions" && r.Method == http.MethodPost:
+		self.doAction(w, r, path[1], path[2])
+	case len(path) == 2 && path[0] == "data" && r.Method == http.MethodPost:
+		self.pushData(w, r, path[1])
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// screenshot renders the Roots as text, holding the RenderLock.
+func (self *Server) screenshot() string {
+	if self.RenderLock != nil {
+		self.RenderLock.Lock()
+		defer self.RenderLock.Unlock()
+	}
+	return ui.RenderText(self.Roots...)
+}
+
+// actionable returns the named widget, or writes an error if it doesn't exist or has no actions.
+func (self *Server) actionable(w http.ResponseWriter, name string) (ui.Actionable, bool) {
+	widget, ok := self.Widgets[name].(ui.Actionable)
+	if !ok {
+		http.Error(w, fmt.Sprintf("no widget %q with actions", name), http.StatusNotFound)
+	}
+	return widget, ok
+}
+
+func (self *Server) doAction(w http.ResponseWriter, r *http.Request, name, action string) {
+	widget, ok := self.actionable(w, name)
+	if !ok {
+		return
+	}
+	args := []interface{}{}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(&args); err != nil {
+			http.Error(w, "invalid arguments: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	// JSON numbers are decoded as float64, but actions take ints
+	for i, arg := range args {
+		if f, ok := arg.(float64); ok && isInt(f) {
+			args[i] = int(f)
+		}
+	}
+
+	widget.(ui.Drawable).Lock()
+	err := widget.Do(action, args...)
+	widget.(ui.Drawable).Unlock()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	self.changed()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (self *Server) pushData(w http.ResponseWriter, r *http.Request, name string) {
+	binding, ok := self.Bindings[name]
+	if !ok {
+		http.Error(w, fmt.Sprintf("no binding %q", name), http.StatusNotFound)
+		return
+	}
+	var value float64
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(&value); err != nil {
+		http.Error(w, "invalid value: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	// Push locks the Plot around the Transform
+	binding.Push(value)
+	self.changed()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// isInt reports whether f is a whole number within the range of int.
+func isInt(f float64) bool {
+	maxInt := float64(uint64(1) << (strconv.IntSize - 1))
+	return math.Trunc(f) == f && f >= -maxInt && f < maxInt
+}
+
+func (self *Server) changed() {
+	self.changeMu.Lock()
+	defer self.changeMu.Unlock()
+	if self.OnChange != nil {
+		self.OnChange()
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
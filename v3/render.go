@@ -19,20 +19,47 @@ type Drawable interface {
 }
 
 func Render(items ...Drawable) {
+	links := []hyperlink{}
+	bitmaps := []Bitmap{}
+	rects := []image.Rectangle{}
 	for _, item := range items {
 		buf := NewBuffer(item.GetRect())
 		item.Lock()
 		item.Draw(buf)
 		item.Unlock()
-		for point, cell := range buf.CellMap {
-			if point.In(buf.Rectangle) {
-				tb.SetCell(
-					point.X, point.Y,
-					cell.Rune,
-					tb.Attribute(cell.Style.Fg+1)|tb.Attribute(cell.Style.Modifier), tb.Attribute(cell.Style.Bg+1),
-				)
+		setTerminalCells(buf)
+		setHitRegions(item, buf.HitRegions)
+		// hyperlinks covered by later items would be drawn over them, so they are dropped
+		links = append(uncoveredHyperlinks(links, item.GetRect()), bufferHyperlinks(buf)...)
+		// bitmaps covered by later items would hide them, so their fallback cells are shown instead
+		bitmaps = append(uncoveredBitmaps(bitmaps, item.GetRect()), buf.Bitmaps...)
+		rects = append(rects, item.GetRect())
+	}
+	tb.Flush()
+	writeOverlays(links, bitmaps, rects...)
+}
+
+// RenderCells draws an already rendered Buffer to the terminal,
+// e.g. the result of compositing operations like BlendBuffers.
+func RenderCells(buf *Buffer) {
+	setTerminalCells(buf)
+	tb.Flush()
+	writeOverlays(bufferHyperlinks(buf), buf.Bitmaps, buf.Rectangle)
+}
+
+func setTerminalCells(buf *Buffer) {
+	safeArea := safeAreaRect()
+	for point, cell := range buf.CellMap {
+		if point.In(buf.Rectangle) && point.In(safeArea) {
+			if buf.Links[point] != "" {
+				cell.Style = hyperlinkStyle(cell.Style)
 			}
+			cell.Style = EnforceContrast(cell.Style, Theme.MinContrast)
+			tb.SetCell(
+				point.X+SafeArea.Left, point.Y+SafeArea.Top,
+				cell.Rune,
+				tb.Attribute(cell.Style.Fg+1)|tb.Attribute(cell.Style.Modifier), tb.Attribute(cell.Style.Bg+1),
+			)
 		}
 	}
-	tb.Flush()
 }
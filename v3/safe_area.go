@@ -0,0 +1,32 @@
+package termui
+
+import (
+	"image"
+
+	tb "github.com/nsf/termbox-go"
+)
+
+// Margins is a number of rows or columns at each edge of the terminal.
+type Margins struct {
+	Top    int
+	Bottom int
+	Left   int
+	Right  int
+}
+
+// SafeArea reserves rows and columns at the edges of the terminal that are never drawn to,
+// e.g. for a tmux status bar or a persistent shell prompt. TerminalDimensions and Resize events
+// report the size of the remaining area, and widgets are positioned and mouse events reported
+// relative to its top left corner, so that Grid and absolute positioning respect it without changes.
+var SafeArea Margins
+
+// safeAreaSize returns the size of the area left by SafeArea in a terminal of the given size.
+func safeAreaSize(width, height int) (int, int) {
+	return MaxInt(width-SafeArea.Left-SafeArea.Right, 0), MaxInt(height-SafeArea.Top-SafeArea.Bottom, 0)
+}
+
+// safeAreaRect returns the area left by SafeArea in the current terminal, relative to its top left corner.
+func safeAreaRect() image.Rectangle {
+	width, height := safeAreaSize(tb.Size())
+	return image.Rect(0, 0, width, height)
+}
@@ -0,0 +1,45 @@
+package termui
+
+import (
+	"image"
+)
+
+// Scrollbar draws a vertical bar whose thumb shows the position and size of the
+// visible part of a scrolling widget's content.
+type Scrollbar struct {
+	TrackRune  rune
+	ThumbRune  rune
+	TrackStyle Style
+	ThumbStyle Style
+}
+
+func NewScrollbar() *Scrollbar {
+	return &Scrollbar{
+		TrackRune:  Theme.Scrollbar.TrackRune,
+		ThumbRune:  Theme.Scrollbar.ThumbRune,
+		TrackStyle: Theme.Scrollbar.Track,
+		ThumbStyle: Theme.Scrollbar.Thumb,
+	}
+}
+
+// Draw draws the scrollbar into the column x from yMin to yMax for content of total lines,
+// of which visible lines starting at offset are shown.
+func (self *Scrollbar) Draw(buf *Buffer, x, yMin, yMax, total, offset, visible int) {
+	height := yMax - yMin
+	if height <= 0 {
+		return
+	}
+	thumbHeight, thumbOffset := height, 0
+	if total > visible && total > 0 {
+		thumbHeight = MaxInt(height*visible/total, 1)
+		thumbOffset = (height - thumbHeight) * offset / MaxInt(total-visible, 1)
+		thumbOffset = MaxInt(MinInt(thumbOffset, height-thumbHeight), 0)
+	}
+	for y := yMin; y < yMax; y++ {
+		cell := NewCell(self.TrackRune, self.TrackStyle)
+		if y >= yMin+thumbOffset && y < yMin+thumbOffset+thumbHeight {
+			cell = NewCell(self.ThumbRune, self.ThumbStyle)
+		}
+		buf.SetCell(cell, image.Pt(x, y))
+	}
+}
@@ -0,0 +1,87 @@
+package termui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Severity classifies states and messages, e.g. log levels or health checks, so that
+// widgets color them consistently with the styles of Theme.Severity.
+type Severity uint
+
+const (
+	SeverityOK Severity = iota
+	SeverityInfo
+	SeverityWarn
+	SeverityError
+	SeverityCritical
+)
+
+var severityNames = []string{"ok", "info", "warn", "error", "critical"}
+
+func (self Severity) String() string {
+	if int(self) < len(severityNames) {
+		return severityNames[self]
+	}
+	return fmt.Sprintf("Severity(%d)", uint(self))
+}
+
+// Style returns the style of the severity in the current Theme.
+func (self Severity) Style() Style {
+	switch self {
+	case SeverityOK:
+		return Theme.Severity.OK
+	case SeverityInfo:
+		return Theme.Severity.Info
+	case SeverityWarn:
+		return Theme.Severity.Warn
+	case SeverityError:
+		return Theme.Severity.Error
+	default:
+		return Theme.Severity.Critical
+	}
+}
+
+// ParseSeverity parses the name of a severity, ignoring case. Common aliases like
+// "warning", "err" and "fatal" are accepted, too.
+func ParseSeverity(s string) (Severity, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "ok", "success":
+		return SeverityOK, nil
+	case "info", "debug", "notice":
+		return SeverityInfo, nil
+	case "warn", "warning":
+		return SeverityWarn, nil
+	case "error", "err":
+		return SeverityError, nil
+	case "critical", "crit", "fatal", "panic":
+		return SeverityCritical, nil
+	}
+	return SeverityOK, fmt.Errorf("unknown severity %q", s)
+}
+
+// Threshold is the Value from which a widget is drawn in the color of the Severity, or
+// in Color if it is set, e.g. Gauge.Thresholds.
+type Threshold struct {
+	Value    float64
+	Severity Severity
+	Color    *Color
+}
+
+// ThresholdColor returns the color of the highest of the thresholds that value reaches,
+// or color if it reaches none.
+func ThresholdColor(thresholds []Threshold, value float64, color Color) Color {
+	reached := -1
+	for i, threshold := range thresholds {
+		if value >= threshold.Value && (reached < 0 || threshold.Value >= thresholds[reached].Value) {
+			reached = i
+		}
+	}
+	if reached < 0 {
+		return color
+	}
+	if thresholds[reached].Color != nil {
+		return *thresholds[reached].Color
+	}
+	return thresholds[reached].Severity.Style().Fg
+}
@@ -0,0 +1,127 @@
+package termui
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	rw "github.com/mattn/go-runewidth"
+)
+
+// LabelShortener shortens a label to fit into the given width.
+// TrimString, TruncateMiddle and ShortenPath are LabelShorteners.
+type LabelShortener func(s string, w int) string
+
+// TruncateMiddle trims a string to a max length by replacing its middle with '…'.
+func TruncateMiddle(s string, w int) string {
+	if w <= 0 {
+		return ""
+	}
+	if rw.StringWidth(s) <= w {
+		return s
+	}
+	runes := []rune(s)
+	leftWidth := w / 2
+	rightWidth := w - 1 - leftWidth
+
+	var left strings.Builder
+	width := 0
+	for _, r := range runes {
+		if width+rw.RuneWidth(r) > leftWidth {
+			break
+		}
+		left.WriteRune(r)
+		width += rw.RuneWidth(r)
+	}
+
+	start := len(runes)
+	width = 0
+	for i := len(runes) - 1; i >= 0; i-- {
+		if width+rw.RuneWidth(runes[i]) > rightWidth {
+			break
+		}
+		start = i
+		width += rw.RuneWidth(runes[i])
+	}
+
+	return left.String() + string(ELLIPSES) + string(runes[start:])
+}
+
+// ShortenPath shortens a slash separated path to a max length. The home directory is
+// replaced by '~' and leading directories are abbreviated to their first letter,
+// e.g. /home/user/projects/termui becomes ~/p/termui.
+// If that isn't enough, the result is truncated in the middle.
+func ShortenPath(s string, w int) string {
+	if rw.StringWidth(s) <= w {
+		return s
+	}
+	if home, err := os.UserHomeDir(); err == nil && home != "" && home != "/" {
+		if s == home || strings.HasPrefix(s, home+"/") {
+			s = "~" + strings.TrimPrefix(s, home)
+		}
+	}
+
+	parts := strings.Split(s, "/")
+	for i := 0; i < len(parts)-1 && rw.StringWidth(strings.Join(parts, "/")) > w; i++ {
+		runes := []rune(parts[i])
+		switch {
+		case len(runes) == 0 || parts[i] == "~":
+		case runes[0] == '.' && len(runes) > 2:
+			parts[i] = string(runes[:2])
+		case len(runes) > 1:
+			parts[i] = string(runes[:1])
+		}
+	}
+
+	return TruncateMiddle(strings.Join(parts, "/"), w)
+}
+
+var numberSuffixes = []string{"", "k", "M", "G", "T", "P", "E"}
+
+// AbbreviateNumber formats a number with at most one decimal and a metric suffix,
+// e.g. 12345 becomes 12.3k.
+func AbbreviateNumber(n float64) string {
+	i := 0
+	for math.Abs(n) >= 999.95 && i < len(numberSuffixes)-1 {
+		n /= 1000
+		i++
+	}
+	return strconv.FormatFloat(RoundFloat64(n*10)/10, 'f', -1, 64) + numberSuffixes[i]
+}
+
+var byteSuffixes = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+
+// FormatBytes formats a number of bytes with at most one decimal and a binary suffix,
+// e.g. 1536 becomes 1.5KiB.
+func FormatBytes(n float64) string {
+	i := 0
+	for math.Abs(n) >= 1023.95 && i < len(byteSuffixes)-1 {
+		n /= 1024
+		i++
+	}
+	return strconv.FormatFloat(RoundFloat64(n*10)/10, 'f', -1, 64) + byteSuffixes[i]
+}
+
+// FormatSeconds formats a duration given in seconds with its two largest units,
+// e.g. 3725 becomes 1h2m.
+func FormatSeconds(n float64) string {
+	d := time.Duration(n * float64(time.Second))
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+	switch {
+	case d >= 24*time.Hour:
+		return fmt.Sprintf("%s%dd%dh", sign, d/(24*time.Hour), d%(24*time.Hour)/time.Hour)
+	case d >= time.Hour:
+		return fmt.Sprintf("%s%dh%dm", sign, d/time.Hour, d%time.Hour/time.Minute)
+	case d >= time.Minute:
+		return fmt.Sprintf("%s%dm%ds", sign, d/time.Minute, d%time.Minute/time.Second)
+	case d >= time.Second:
+		return sign + strconv.FormatFloat(RoundFloat64(d.Seconds()*10)/10, 'f', -1, 64) + "s"
+	}
+	return sign + strconv.FormatFloat(RoundFloat64(float64(d)/float64(time.Millisecond)*10)/10, 'f', -1, 64) + "ms"
+}
@@ -0,0 +1,82 @@
+package termui
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"sort"
+	"strings"
+
+	colorpalette "image/color/palette"
+)
+
+// sixelTransparency is the alpha below which pixels are left transparent.
+const sixelTransparency = 0x8000
+
+// EncodeSixel returns the sixel sequence that draws img at the cursor position.
+// The colors are reduced to 256 with dithering and transparent pixels are skipped.
+func EncodeSixel(img image.Image) string {
+	bounds := img.Bounds()
+	paletted := image.NewPaletted(bounds, colorpalette.Plan9)
+	draw.FloydSteinberg.Draw(paletted, bounds, img, bounds.Min)
+
+	var sb strings.Builder
+	// P2 = 1 keeps the background of transparent pixels
+	sb.WriteString("\x1bP0;1;0q")
+	fmt.Fprintf(&sb, "\"1;1;%d;%d", bounds.Dx(), bounds.Dy())
+
+	defined := make(map[uint8]bool)
+	for y0 := bounds.Min.Y; y0 < bounds.Max.Y; y0 += 6 {
+		// the bits of the six rows of the band per color and column
+		band := make(map[uint8][]byte)
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			for dy := 0; dy < 6 && y0+dy < bounds.Max.Y; dy++ {
+				if _, _, _, a := img.At(x, y0+dy).RGBA(); a < sixelTransparency {
+					continue
+				}
+				index := paletted.ColorIndexAt(x, y0+dy)
+				if band[index] == nil {
+					band[index] = make([]byte, bounds.Dx())
+				}
+				band[index][x-bounds.Min.X] |= 1 << uint(dy)
+			}
+		}
+
+		indices := []int{}
+		for index := range band {
+			indices = append(indices, int(index))
+		}
+		sort.Ints(indices)
+		for _, i := range indices {
+			index := uint8(i)
+			if !defined[index] {
+				r, g, b, _ := colorpalette.Plan9[index].RGBA()
+				fmt.Fprintf(&sb, "#%d;2;%d;%d;%d", index, r*100/0xffff, g*100/0xffff, b*100/0xffff)
+				defined[index] = true
+			}
+			fmt.Fprintf(&sb, "#%d", index)
+			writeSixelRun(&sb, band[index])
+			sb.WriteByte('$')
+		}
+		sb.WriteByte('-')
+	}
+	sb.WriteString("\x1b\\")
+	return sb.String()
+}
+
+// writeSixelRun writes the sixels of a color in a band, compressing repeated sixels.
+func writeSixelRun(sb *strings.Builder, bits []byte) {
+	for i := 0; i < len(bits); {
+		j := i
+		for j < len(bits) && bits[j] == bits[i] {
+			j++
+		}
+		sixel := byte('?' + bits[i])
+		if count := j - i; count > 3 {
+			fmt.Fprintf(sb, "!%d%c", count, sixel)
+		} else {
+			sb.WriteString(strings.Repeat(string(sixel), count))
+		}
+		i = j
+	}
+}
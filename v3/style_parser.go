@@ -12,6 +12,7 @@ const (
 	tokenFg       = "fg"
 	tokenBg       = "bg"
 	tokenModifier = "mod"
+	tokenURL      = "url"
 
 	tokenItemSeparator  = ","
 	tokenValueSeparator = ":"
@@ -50,11 +51,18 @@ var modifierMap = map[string]Modifier{
 	"reverse":   ModifierReverse,
 }
 
-// readStyle translates an []rune like `fg:red,mod:bold,bg:white` to a style
-func readStyle(runes []rune, defaultStyle Style) Style {
+// readStyle translates an []rune like `fg:red,mod:bold,bg:white,url:<url>` to a style
+// and a url
+func readStyle(runes []rune, defaultStyle Style) (Style, string) {
 	style := defaultStyle
+	url := ""
 	split := strings.Split(string(runes), tokenItemSeparator)
 	for _, item := range split {
+		// URLs contain the value separator themselves
+		if strings.HasPrefix(item, tokenURL+tokenValueSeparator) {
+			url = strings.TrimPrefix(item, tokenURL+tokenValueSeparator)
+			continue
+		}
 		pair := strings.Split(item, tokenValueSeparator)
 		if len(pair) == 2 {
 			switch pair[0] {
@@ -67,15 +75,29 @@ func readStyle(runes []rune, defaultStyle Style) Style {
 			}
 		}
 	}
-	return style
+	return style, url
 }
 
 // ParseStyles parses a string for embedded Styles and returns []Cell with the correct styling.
 // Uses defaultStyle for any text without an embedded style.
-// Syntax is of the form [text](fg:<color>,mod:<attribute>,bg:<color>).
-// Ordering does not matter. All fields are optional.
+// Syntax is of the form [text](fg:<color>,mod:<attribute>,bg:<color>,url:<url>).
+// Ordering does not matter. All fields are optional. URLs must not contain commas,
+// see ParseStyleLinks.
 func ParseStyles(s string, defaultStyle Style) []Cell {
+	cells, _ := parseStyles(s, defaultStyle)
+	return cells
+}
+
+// ParseStyleLinks returns the texts with a url in their embedded style, see Buffer.SetLinks.
+func ParseStyleLinks(s string) []Link {
+	_, links := parseStyles(s, StyleClear)
+	return links
+}
+
+// parseStyles returns the cells and the links of s.
+func parseStyles(s string, defaultStyle Style) ([]Cell, []Link) {
 	cells := []Cell{}
+	links := []Link{}
 	runes := []rune(s)
 	state := parserStateDefault
 	styledText := []rune{}
@@ -143,8 +165,11 @@ func ParseStyles(s string, defaultStyle Style) []Cell {
 		case parserStateStyleItems:
 			styleItems = append(styleItems, _rune)
 			if _rune == tokenEndStyle {
-				style := readStyle(chop(styleItems), defaultStyle)
+				style, url := readStyle(chop(styleItems), defaultStyle)
 				cells = append(cells, RunesToStyledCells(chop(styledText), style)...)
+				if url != "" {
+					links = append(links, Link{string(chop(styledText)), url})
+				}
 				reset()
 			} else if len(runes) == i+1 {
 				rollback()
@@ -152,5 +177,5 @@ func ParseStyles(s string, defaultStyle Style) []Cell {
 		}
 	}
 
-	return cells
+	return cells, links
 }
@@ -9,6 +9,10 @@ const (
 
 	COLLAPSED = '+'
 	EXPANDED  = '−'
+
+	CLOSE = '×'
+
+	UPPER_HALF_BLOCK = '▀'
 )
 
 var (
@@ -27,19 +27,46 @@ var StandardStyles = []Style{
 type RootTheme struct {
 	Default Style
 
+	// MinContrast is the minimum contrast ratio (1-21) enforced between the Fg and Bg
+	// of every rendered cell. Offending Fg colors are replaced with the closest xterm
+	// color that satisfies it. A value of 0 disables the check.
+	MinContrast float64
+
 	Block BlockTheme
 
+	// Severity holds the styles of the severities, which are shared by all widgets.
+	Severity SeverityTheme
+
+	// Hyperlink is applied to linked cells if the terminal doesn't support hyperlinks.
+	// Its Fg replaces the Fg of the cell unless it is ColorClear, its Modifier is added.
+	Hyperlink Style
+
 	BarChart        BarChartTheme
+	Button          ButtonTheme
+	Calendar        CalendarTheme
+	CodeView        CodeViewTheme
+	FileBrowser     FileBrowserTheme
+	Form            FormTheme
 	Gauge           GaugeTheme
+	HeatStrip       HeatStripTheme
+	Inspector       InspectorTheme
 	Plot            PlotTheme
 	List            ListTheme
+	LogView         LogViewTheme
+	Menu            MenuTheme
 	Tree            TreeTheme
 	Paragraph       ParagraphTheme
 	PieChart        PieChartTheme
+	Scrollbar       ScrollbarTheme
+	Skeleton        SkeletonTheme
+	Slider          SliderTheme
 	Sparkline       SparklineTheme
 	StackedBarChart StackedBarChartTheme
+	StatPanel       StatPanelTheme
+	StatusBar       StatusBarTheme
 	Tab             TabTheme
 	Table           TableTheme
+	TextInput       TextInputTheme
 }
 
 type BlockTheme struct {
@@ -47,10 +74,58 @@ type BlockTheme struct {
 	Border Style
 }
 
+type SeverityTheme struct {
+	OK       Style
+	Info     Style
+	Warn     Style
+	Error    Style
+	Critical Style
+}
+
 type BarChartTheme struct {
-	Bars   []Color
-	Nums   []Style
-	Labels []Style
+	Bars          []Color
+	NegativeBars  []Color
+	Nums          []Style
+	Labels        []Style
+	SelectedBar   Color
+	SelectedLabel Style
+}
+
+type ButtonTheme struct {
+	Text    Style
+	Focused Style
+	Pressed Style
+}
+
+type CalendarTheme struct {
+	Header    Style
+	Text      Style
+	Cursor    Style
+	Range     Style
+	Today     Style
+	Highlight Style
+}
+
+type CodeViewTheme struct {
+	Text        Style
+	Keyword     Style
+	String      Style
+	Comment     Style
+	Number      Style
+	Function    Style
+	LineNumber  Style
+	CurrentLine Style
+}
+
+type FileBrowserTheme struct {
+	Directory Style
+}
+
+type FormTheme struct {
+	Label        Style
+	FocusedLabel Style
+	Error        Style
+	Input        Style
 }
 
 type GaugeTheme struct {
@@ -58,32 +133,86 @@ type GaugeTheme struct {
 	Label Style
 }
 
+type InspectorTheme struct {
+	Text      Style
+	Selected  Style
+	Highlight Style
+}
+
 type PlotTheme struct {
-	Lines []Color
-	Axes  Color
+	Lines  []Color
+	Axes   Color
+	Cursor Style
+}
+
+type HeatStripTheme struct {
+	Text    Style
+	Levels  [3]Color
+	Missing Color
 }
 
 type ListTheme struct {
-	Text Style
+	Text    Style
+	Match   Style
+	Section Style
+}
+
+type LogViewTheme struct {
+	Text         Style
+	Timestamp    Style
+	Match        Style
+	CurrentMatch Style
+}
+
+type MenuTheme struct {
+	Text     Style
+	Selected Style
+	Disabled Style
 }
 
 type TreeTheme struct {
-	Text      Style
-	Collapsed rune
-	Expanded  rune
+	Text       Style
+	Match      Style
+	DropTarget Style
+	Collapsed  rune
+	Expanded   rune
 }
 
 type ParagraphTheme struct {
-	Text Style
+	Text         Style
+	Markdown     MarkdownStyles
+	Match        Style
+	CurrentMatch Style
 }
 
 type PieChartTheme struct {
-	Slices []Color
+	Slices  []Color
+	Caption Style
+}
+
+type ScrollbarTheme struct {
+	Track     Style
+	Thumb     Style
+	TrackRune rune
+	ThumbRune rune
+}
+
+type SliderTheme struct {
+	Track  Style
+	Filled Style
+	Thumb  Style
+	Label  Style
+}
+
+type SkeletonTheme struct {
+	Base    Style
+	Shimmer Style
 }
 
 type SparklineTheme struct {
-	Title Style
-	Line  Color
+	Title      Style
+	Line       Color
+	Annotation Style
 }
 
 type StackedBarChartTheme struct {
@@ -92,13 +221,39 @@ type StackedBarChartTheme struct {
 	Labels []Style
 }
 
+type StatPanelTheme struct {
+	Value     Style
+	Increase  Style
+	Decrease  Style
+	Sparkline Color
+}
+
+type StatusBarTheme struct {
+	Text      Style
+	Separator Style
+}
+
 type TabTheme struct {
 	Active   Style
 	Inactive Style
+	Close    Style
+	Badge    Style
+}
+
+type TextInputTheme struct {
+	Text        Style
+	Placeholder Style
+	Selection   Style
 }
 
 type TableTheme struct {
-	Text Style
+	Text     Style
+	Selected Style
+	Edit     Style
+	Error    Style
+	Match    Style
+
+	FrozenSeparator Style
 }
 
 // Theme holds the default Styles and Colors for all widgets.
@@ -111,28 +266,73 @@ var Theme = RootTheme{
 		Border: NewStyle(ColorWhite),
 	},
 
+	Severity: SeverityTheme{
+		OK:       NewStyle(ColorGreen),
+		Info:     NewStyle(ColorCyan),
+		Warn:     NewStyle(ColorYellow),
+		Error:    NewStyle(ColorRed),
+		Critical: NewStyle(ColorWhite, ColorRed, ModifierBold),
+	},
+
+	Hyperlink: NewStyle(ColorBlue, ColorClear, ModifierUnderline),
+
 	BarChart: BarChartTheme{
-		Bars:   StandardColors,
-		Nums:   StandardStyles,
-		Labels: StandardStyles,
+		Bars:          StandardColors,
+		NegativeBars:  []Color{ColorRed},
+		Nums:          StandardStyles,
+		Labels:        StandardStyles,
+		SelectedBar:   ColorWhite,
+		SelectedLabel: NewStyle(ColorWhite, ColorClear, ModifierReverse),
+	},
+
+	HeatStrip: HeatStripTheme{
+		Text:    NewStyle(ColorWhite),
+		Levels:  [3]Color{ColorRed, ColorYellow, ColorGreen},
+		Missing: ColorWhite,
 	},
 
 	Paragraph: ParagraphTheme{
 		Text: NewStyle(ColorWhite),
+		Markdown: MarkdownStyles{
+			Heading: NewStyle(ColorCyan, ColorClear, ModifierBold),
+			Code:    NewStyle(ColorYellow),
+			Quote:   NewStyle(ColorGreen),
+			Bullet:  NewStyle(ColorCyan),
+		},
+		Match:        NewStyle(ColorBlack, ColorYellow),
+		CurrentMatch: NewStyle(ColorBlack, ColorRed, ModifierBold),
 	},
 
 	PieChart: PieChartTheme{
-		Slices: StandardColors,
+		Slices:  StandardColors,
+		Caption: NewStyle(ColorWhite, ColorClear, ModifierBold),
 	},
 
 	List: ListTheme{
-		Text: NewStyle(ColorWhite),
+		Text:    NewStyle(ColorWhite),
+		Match:   NewStyle(ColorBlack, ColorYellow),
+		Section: NewStyle(ColorWhite, ColorClear, ModifierBold),
+	},
+
+	LogView: LogViewTheme{
+		Text:         NewStyle(ColorWhite),
+		Timestamp:    NewStyle(Color(8)),
+		Match:        NewStyle(ColorBlack, ColorYellow),
+		CurrentMatch: NewStyle(ColorBlack, ColorRed, ModifierBold),
+	},
+
+	Menu: MenuTheme{
+		Text:     NewStyle(ColorWhite),
+		Selected: NewStyle(ColorBlack, ColorWhite),
+		Disabled: NewStyle(ColorBlack),
 	},
 
 	Tree: TreeTheme{
-		Text:      NewStyle(ColorWhite),
-		Collapsed: COLLAPSED,
-		Expanded:  EXPANDED,
+		Text:       NewStyle(ColorWhite),
+		Match:      NewStyle(ColorBlack, ColorYellow),
+		DropTarget: NewStyle(ColorBlack, ColorCyan),
+		Collapsed:  COLLAPSED,
+		Expanded:   EXPANDED,
 	},
 
 	StackedBarChart: StackedBarChartTheme{
@@ -141,27 +341,117 @@ var Theme = RootTheme{
 		Labels: StandardStyles,
 	},
 
+	Button: ButtonTheme{
+		Text:    NewStyle(ColorWhite),
+		Focused: NewStyle(ColorBlack, ColorWhite),
+		Pressed: NewStyle(ColorBlack, ColorYellow),
+	},
+
+	Calendar: CalendarTheme{
+		Header:    NewStyle(ColorYellow, ColorClear, ModifierBold),
+		Text:      NewStyle(ColorWhite),
+		Cursor:    NewStyle(ColorBlack, ColorWhite),
+		Range:     NewStyle(ColorBlack, ColorCyan),
+		Today:     NewStyle(ColorWhite, ColorClear, ModifierUnderline),
+		Highlight: NewStyle(ColorGreen, ColorClear, ModifierBold),
+	},
+
+	CodeView: CodeViewTheme{
+		Text:        NewStyle(ColorWhite),
+		Keyword:     NewStyle(ColorMagenta, ColorClear, ModifierBold),
+		String:      NewStyle(ColorGreen),
+		Comment:     NewStyle(Color(8)),
+		Number:      NewStyle(ColorYellow),
+		Function:    NewStyle(ColorCyan),
+		LineNumber:  NewStyle(Color(8)),
+		CurrentLine: NewStyle(ColorWhite, Color(236)),
+	},
+
+	FileBrowser: FileBrowserTheme{
+		Directory: NewStyle(ColorBlue, ColorClear, ModifierBold),
+	},
+
+	Form: FormTheme{
+		Label:        NewStyle(ColorWhite),
+		FocusedLabel: NewStyle(ColorYellow, ColorClear, ModifierBold),
+		Error:        NewStyle(ColorRed),
+		Input:        NewStyle(ColorWhite),
+	},
+
 	Gauge: GaugeTheme{
 		Bar:   ColorWhite,
 		Label: NewStyle(ColorWhite),
 	},
 
+	Inspector: InspectorTheme{
+		Text:      NewStyle(ColorWhite),
+		Selected:  NewStyle(ColorBlack, ColorWhite),
+		Highlight: NewStyle(ColorBlack, ColorMagenta),
+	},
+
+	Scrollbar: ScrollbarTheme{
+		Track:     NewStyle(ColorWhite),
+		Thumb:     NewStyle(ColorWhite),
+		TrackRune: SHADED_BLOCKS[1],
+		ThumbRune: SHADED_BLOCKS[4],
+	},
+
+	Slider: SliderTheme{
+		Track:  NewStyle(Color(8)),
+		Filled: NewStyle(ColorCyan),
+		Thumb:  NewStyle(ColorWhite, ColorClear, ModifierBold),
+		Label:  NewStyle(ColorWhite),
+	},
+
+	Skeleton: SkeletonTheme{
+		Base:    NewStyle(ColorWhite),
+		Shimmer: NewStyle(ColorWhite, ColorClear, ModifierBold),
+	},
+
 	Sparkline: SparklineTheme{
-		Title: NewStyle(ColorWhite),
-		Line:  ColorWhite,
+		Title:      NewStyle(ColorWhite),
+		Line:       ColorWhite,
+		Annotation: NewStyle(ColorWhite, ColorClear, ModifierBold),
 	},
 
 	Plot: PlotTheme{
-		Lines: StandardColors,
-		Axes:  ColorWhite,
+		Lines:  StandardColors,
+		Axes:   ColorWhite,
+		Cursor: NewStyle(ColorYellow),
 	},
 
 	Table: TableTheme{
-		Text: NewStyle(ColorWhite),
+		Text:     NewStyle(ColorWhite),
+		Selected: NewStyle(ColorWhite, ColorClear, ModifierReverse),
+		Edit:     NewStyle(ColorBlack, ColorWhite),
+		Error:    NewStyle(ColorRed),
+		Match:    NewStyle(ColorBlack, ColorYellow),
+
+		FrozenSeparator: NewStyle(ColorYellow),
+	},
+
+	TextInput: TextInputTheme{
+		Text:        NewStyle(ColorWhite),
+		Placeholder: NewStyle(Color(8)),
+		Selection:   NewStyle(ColorBlack, ColorWhite),
+	},
+
+	StatPanel: StatPanelTheme{
+		Value:     NewStyle(ColorWhite, ColorClear, ModifierBold),
+		Increase:  NewStyle(ColorGreen),
+		Decrease:  NewStyle(ColorRed),
+		Sparkline: ColorBlue,
+	},
+
+	StatusBar: StatusBarTheme{
+		Text:      NewStyle(ColorBlack, ColorWhite),
+		Separator: NewStyle(Color(8), ColorWhite),
 	},
 
 	Tab: TabTheme{
 		Active:   NewStyle(ColorRed),
 		Inactive: NewStyle(ColorWhite),
+		Close:    NewStyle(ColorWhite),
+		Badge:    NewStyle(ColorYellow),
 	},
 }
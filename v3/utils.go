@@ -93,6 +93,19 @@ func GetMaxFloat64FromSlice(slice []float64) (float64, error) {
 	return max, nil
 }
 
+func GetMinFloat64FromSlice(slice []float64) (float64, error) {
+	if len(slice) == 0 {
+		return 0, fmt.Errorf("cannot get min value from empty slice")
+	}
+	min := math.Inf(1)
+	for _, val := range slice {
+		if val < min {
+			min = val
+		}
+	}
+	return min, nil
+}
+
 func GetMaxFloat64From2dSlice(slices [][]float64) (float64, error) {
 	if len(slices) == 0 {
 		return 0, fmt.Errorf("cannot get max value from empty slice")
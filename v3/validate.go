@@ -0,0 +1,50 @@
+package termui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Validator is implemented by widgets that can check their configuration before they are drawn.
+type Validator interface {
+	Validate() error
+}
+
+// ValidationError lists the configuration errors of a widget tree.
+type ValidationError struct {
+	Errors []error
+}
+
+func (self *ValidationError) Error() string {
+	messages := make([]string, len(self.Errors))
+	for i, err := range self.Errors {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// ValidateTree validates the given widgets and, for Containers like Grid, their children.
+// It returns a *ValidationError listing the errors of all invalid widgets, or nil.
+func ValidateTree(roots ...Drawable) error {
+	errs := []error{}
+	var walk func(widget Drawable)
+	walk = func(widget Drawable) {
+		if validator, ok := widget.(Validator); ok {
+			if err := validator.Validate(); err != nil {
+				errs = append(errs, fmt.Errorf("%T: %v", widget, err))
+			}
+		}
+		if container, ok := widget.(Container); ok {
+			for _, child := range container.Children() {
+				walk(child)
+			}
+		}
+	}
+	for _, root := range roots {
+		walk(root)
+	}
+	if len(errs) > 0 {
+		return &ValidationError{errs}
+	}
+	return nil
+}
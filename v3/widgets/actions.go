@@ -0,0 +1,427 @@
+package widgets
+
+import (
+	. "github.com/s-westphal/termui/v3"
+)
+
+func (self *List) actions() ActionMap {
+	return ActionMap{
+		"scrollUp":       NoArgAction(self.ScrollUp),
+		"scrollDown":     NoArgAction(self.ScrollDown),
+		"scrollPageUp":   NoArgAction(self.ScrollPageUp),
+		"scrollPageDown": NoArgAction(self.ScrollPageDown),
+		"scrollTop":      NoArgAction(self.ScrollTop),
+		"scrollBottom":   NoArgAction(self.ScrollBottom),
+		"scrollLeft":     NoArgAction(self.ScrollLeft),
+		"scrollRight":    NoArgAction(self.ScrollRight),
+		"selectRow":      IntAction(func(row int) { self.SelectedRow = MaxInt(MinInt(row, self.rowCount()-1), 0) }),
+		"toggleSelected": NoArgAction(self.ToggleSelected),
+		"selectAll":      NoArgAction(self.SelectAll),
+		"selectNone":     NoArgAction(self.SelectNone),
+		"moveUp":         NoArgAction(self.MoveSelectedUp),
+		"moveDown":       NoArgAction(self.MoveSelectedDown),
+		"search":         StringAction(func(query string) { self.Query = query }),
+	}
+}
+
+func (self *List) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *List) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *Table) actions() ActionMap {
+	return ActionMap{
+		"scrollUp":       NoArgAction(self.ScrollUp),
+		"scrollDown":     NoArgAction(self.ScrollDown),
+		"scrollPageUp":   NoArgAction(self.ScrollPageUp),
+		"scrollPageDown": NoArgAction(self.ScrollPageDown),
+		"scrollTop":      NoArgAction(self.ScrollTop),
+		"scrollBottom":   NoArgAction(self.ScrollBottom),
+		"scrollLeft":     NoArgAction(self.ScrollLeft),
+		"scrollRight":    NoArgAction(self.ScrollRight),
+		"selectRow":      IntAction(func(row int) { self.SelectedRow = MaxInt(MinInt(row, self.rowCount()-1), 1) }),
+		"activate":       NoArgAction(self.Activate),
+		"nextPage":       NoArgAction(self.NextPage),
+		"prevPage":       NoArgAction(self.PrevPage),
+		"toggleSort":     IntAction(self.ToggleSort),
+		"search":         StringAction(func(text string) { self.SearchText = text }),
+	}
+}
+
+func (self *Table) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *Table) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *Tree) actions() ActionMap {
+	return ActionMap{
+		"scrollUp":       NoArgAction(self.ScrollUp),
+		"scrollDown":     NoArgAction(self.ScrollDown),
+		"scrollPageUp":   NoArgAction(self.ScrollPageUp),
+		"scrollPageDown": NoArgAction(self.ScrollPageDown),
+		"scrollTop":      NoArgAction(self.ScrollTop),
+		"scrollBottom":   NoArgAction(self.ScrollBottom),
+		"expand":         NoArgAction(self.Expand),
+		"collapse":       NoArgAction(self.Collapse),
+		"toggleExpand":   NoArgAction(self.ToggleExpand),
+		"expandAll":      NoArgAction(self.ExpandAll),
+		"collapseAll":    NoArgAction(self.CollapseAll),
+		"filter":         StringAction(self.Filter),
+		"nextMatch":      NoArgAction(self.NextMatch),
+		"prevMatch":      NoArgAction(self.PrevMatch),
+		"toggleChecked":  NoArgAction(self.ToggleChecked),
+	}
+}
+
+func (self *Tree) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *Tree) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *TabPane) actions() ActionMap {
+	return ActionMap{
+		"focusLeft":  NoArgAction(self.FocusLeft),
+		"focusRight": NoArgAction(self.FocusRight),
+		"selectTab":  IntAction(self.SelectTab),
+		"closeTab":   NoArgAction(self.CloseActiveTab),
+	}
+}
+
+func (self *TabPane) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *TabPane) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *Plot) actions() ActionMap {
+	return ActionMap{
+		"zoomIn":       NoArgAction(self.ZoomIn),
+		"zoomOut":      NoArgAction(self.ZoomOut),
+		"pan":          IntAction(self.Pan),
+		"measure":      NoArgAction(func() { self.SetMeasure(!self.Measure) }),
+		"switchCursor": NoArgAction(self.SwitchCursor),
+		"moveCursor":   IntAction(func(amount int) { self.MoveCursor(float64(amount)) }),
+	}
+}
+
+func (self *Plot) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *Plot) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *BarChart) actions() ActionMap {
+	return ActionMap{
+		"scrollLeft":      NoArgAction(self.ScrollLeft),
+		"scrollRight":     NoArgAction(self.ScrollRight),
+		"scrollPageLeft":  NoArgAction(self.ScrollPageLeft),
+		"scrollPageRight": NoArgAction(self.ScrollPageRight),
+		"selectNext":      NoArgAction(self.SelectNext),
+		"selectPrevious":  NoArgAction(self.SelectPrevious),
+		"select":          IntAction(self.Select),
+		"activate":        NoArgAction(self.Activate),
+	}
+}
+
+func (self *BarChart) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *BarChart) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *Paragraph) actions() ActionMap {
+	return ActionMap{
+		"scrollUp":       NoArgAction(self.ScrollUp),
+		"scrollDown":     NoArgAction(self.ScrollDown),
+		"scrollPageUp":   NoArgAction(self.ScrollPageUp),
+		"scrollPageDown": NoArgAction(self.ScrollPageDown),
+		"scrollTop":      NoArgAction(self.ScrollTop),
+		"scrollBottom":   NoArgAction(self.ScrollBottom),
+		"scrollLeft":     NoArgAction(self.ScrollLeft),
+		"scrollRight":    NoArgAction(self.ScrollRight),
+		"search":         StringAction(func(query string) { self.Search(query) }),
+		"nextMatch":      NoArgAction(self.NextMatch),
+		"prevMatch":      NoArgAction(self.PrevMatch),
+	}
+}
+
+func (self *Paragraph) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *Paragraph) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *CodeView) actions() ActionMap {
+	return ActionMap{
+		"scrollUp":       NoArgAction(self.ScrollUp),
+		"scrollDown":     NoArgAction(self.ScrollDown),
+		"scrollPageUp":   NoArgAction(self.ScrollPageUp),
+		"scrollPageDown": NoArgAction(self.ScrollPageDown),
+		"scrollTop":      NoArgAction(self.ScrollTop),
+		"scrollBottom":   NoArgAction(self.ScrollBottom),
+		"selectLine":     IntAction(func(line int) { self.CurrentLine = line }),
+	}
+}
+
+func (self *CodeView) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *CodeView) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *PieChart) actions() ActionMap {
+	return ActionMap{
+		"selectNext":     NoArgAction(self.SelectNext),
+		"selectPrevious": NoArgAction(self.SelectPrevious),
+		"select":         IntAction(self.Select),
+	}
+}
+
+func (self *PieChart) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *PieChart) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *TextInput) actions() ActionMap {
+	return ActionMap{
+		"moveLeft":           NoArgAction(self.MoveLeft),
+		"moveRight":          NoArgAction(self.MoveRight),
+		"moveWordLeft":       NoArgAction(self.MoveWordLeft),
+		"moveWordRight":      NoArgAction(self.MoveWordRight),
+		"moveHome":           NoArgAction(self.MoveHome),
+		"moveEnd":            NoArgAction(self.MoveEnd),
+		"selectLeft":         NoArgAction(self.SelectLeft),
+		"selectRight":        NoArgAction(self.SelectRight),
+		"selectAll":          NoArgAction(self.SelectAll),
+		"deleteBackward":     NoArgAction(self.DeleteBackward),
+		"deleteForward":      NoArgAction(self.DeleteForward),
+		"deleteWordBackward": NoArgAction(self.DeleteWordBackward),
+		"submit":             NoArgAction(self.Submit),
+	}
+}
+
+func (self *TextInput) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *TextInput) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *Form) actions() ActionMap {
+	return ActionMap{
+		"focusNext":     NoArgAction(self.FocusNext),
+		"focusPrevious": NoArgAction(self.FocusPrevious),
+		"focusField":    IntAction(self.FocusField),
+		"submit":        NoArgAction(func() { self.Submit() }),
+	}
+}
+
+func (self *Form) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *Form) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *Checkbox) actions() ActionMap {
+	return ActionMap{
+		"toggle": NoArgAction(self.Toggle),
+	}
+}
+
+func (self *Checkbox) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *Checkbox) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *Select) actions() ActionMap {
+	return ActionMap{
+		"selectNext":     NoArgAction(self.SelectNext),
+		"selectPrevious": NoArgAction(self.SelectPrevious),
+		"selectOption":   IntAction(self.SelectOption),
+	}
+}
+
+func (self *Select) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *Select) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *Button) actions() ActionMap {
+	return ActionMap{
+		"click": NoArgAction(self.Click),
+	}
+}
+
+func (self *Button) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *Button) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *CheckboxGroup) actions() ActionMap {
+	return ActionMap{
+		"toggleRow":      IntAction(self.ToggleRow),
+		"toggleSelected": NoArgAction(self.ToggleSelected),
+		"selectNext":     NoArgAction(self.SelectNext),
+		"selectPrevious": NoArgAction(self.SelectPrevious),
+		"checkAll":       NoArgAction(func() { self.SetAll(true) }),
+		"uncheckAll":     NoArgAction(func() { self.SetAll(false) }),
+	}
+}
+
+func (self *CheckboxGroup) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *CheckboxGroup) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *RadioGroup) actions() ActionMap {
+	return ActionMap{
+		"selectNext":     NoArgAction(self.SelectNext),
+		"selectPrevious": NoArgAction(self.SelectPrevious),
+		"selectOption":   IntAction(self.SelectOption),
+	}
+}
+
+func (self *RadioGroup) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *RadioGroup) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *Slider) actions() ActionMap {
+	return ActionMap{
+		"increase": IntAction(self.Increase),
+		"decrease": IntAction(self.Decrease),
+	}
+}
+
+func (self *Slider) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *Slider) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *Dialog) actions() ActionMap {
+	return ActionMap{
+		"cancel":        NoArgAction(self.Cancel),
+		"focusNext":     NoArgAction(self.FocusNext),
+		"focusPrevious": NoArgAction(self.FocusPrevious),
+	}
+}
+
+func (self *Dialog) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *Dialog) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *MenuBar) actions() ActionMap {
+	return ActionMap{
+		"openMenu": IntAction(self.OpenMenu),
+		"close":    NoArgAction(self.Close),
+	}
+}
+
+func (self *MenuBar) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *MenuBar) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *Calendar) actions() ActionMap {
+	return ActionMap{
+		"moveDays":   IntAction(self.MoveDays),
+		"moveMonths": IntAction(self.MoveMonths),
+		"moveYears":  IntAction(self.MoveYears),
+		"select":     NoArgAction(self.Select),
+	}
+}
+
+func (self *Calendar) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *Calendar) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *FileBrowser) actions() ActionMap {
+	actions := self.List.actions()
+	actions["open"] = NoArgAction(self.Open)
+	actions["up"] = NoArgAction(self.Up)
+	actions["toggleHidden"] = NoArgAction(self.ToggleHidden)
+	actions["cycleSort"] = NoArgAction(self.CycleSort)
+	return actions
+}
+
+func (self *FileBrowser) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *FileBrowser) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
+
+func (self *LogView) actions() ActionMap {
+	return ActionMap{
+		"scrollUp":     NoArgAction(self.ScrollUp),
+		"scrollDown":   NoArgAction(self.ScrollDown),
+		"scrollTop":    NoArgAction(self.ScrollTop),
+		"scrollBottom": NoArgAction(self.ScrollBottom),
+		"nextMatch":    NoArgAction(self.NextMatch),
+		"prevMatch":    NoArgAction(self.PrevMatch),
+		"clear":        NoArgAction(self.Clear),
+	}
+}
+
+func (self *LogView) Actions() []string {
+	return self.actions().Names()
+}
+
+func (self *LogView) Do(action string, args ...interface{}) error {
+	return self.actions().Do(action, args...)
+}
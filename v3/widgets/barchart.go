@@ -24,48 +24,137 @@ type BarChart struct {
 	BarWidth     int
 	BarGap       int
 	MaxVal       float64
+
+	// NegativeBarColors are the colors of bars with negative values, which extend downward
+	// (or leftward in Horizontal mode) from a zero baseline drawn in BaselineStyle.
+	NegativeBarColors []Color
+	BaselineStyle     Style
+
+	// Series replace Data if set and draw a group of adjacent bars per label, with one bar
+	// per series in the color of the series, e.g. for this week and last week.
+	// SeriesNames are shown as legend in the first line, whose entries are "legend" hit
+	// regions with the index of the series. Grouped bars are always vertical and only show
+	// positive values, Horizontal is ignored.
+	Series      [][]float64
+	SeriesNames []string
+
+	// LabelShortener shortens labels that don't fit below their bar, or into the label
+	// column in Horizontal mode. Vertical labels aren't shortened if it is nil,
+	// the others are trimmed with TrimString.
+	LabelShortener LabelShortener
+
+	// Selectable highlights the SelectedBar with SelectedBarColor and SelectedLabelStyle,
+	// see SelectNext. OnSelect is called when the selection changes and OnActivate by
+	// Activate, e.g. when Enter is pressed to show details of the bar.
+	Selectable         bool
+	SelectedBar        int
+	SelectedBarColor   Color
+	SelectedLabelStyle Style
+	OnSelect           func(index int)
+	OnActivate         func(index int)
+
+	// Offset is the index of the first bar shown if there are more bars than fit,
+	// see ScrollRight. The visible range is shown in the bottom border.
+	Offset int
+
+	// Horizontal draws the bars from left to right with the labels on the left, which suits
+	// long labels. BarWidth is the height of the bars then. It doesn't apply to Series.
+	Horizontal bool
 }
 
 func NewBarChart() *BarChart {
 	return &BarChart{
-		Block:        *NewBlock(),
-		BarColors:    Theme.BarChart.Bars,
-		NumStyles:    Theme.BarChart.Nums,
-		LabelStyles:  Theme.BarChart.Labels,
-		NumFormatter: func(n float64) string { return fmt.Sprint(n) },
-		BarGap:       1,
-		BarWidth:     3,
+		Block:              *NewBlock(),
+		BarColors:          Theme.BarChart.Bars,
+		NegativeBarColors:  Theme.BarChart.NegativeBars,
+		BaselineStyle:      NewStyle(ColorWhite),
+		SelectedBarColor:   Theme.BarChart.SelectedBar,
+		SelectedLabelStyle: Theme.BarChart.SelectedLabel,
+		NumStyles:          Theme.BarChart.Nums,
+		LabelStyles:        Theme.BarChart.Labels,
+		NumFormatter:       func(n float64) string { return fmt.Sprint(n) },
+		BarGap:             1,
+		BarWidth:           3,
 	}
 }
 
+// barColor returns the color of bar i, which depends on the sign of its value and the selection.
+func (self *BarChart) barColor(i int, data float64) Color {
+	if self.Selectable && i == self.SelectedBar {
+		return self.SelectedBarColor
+	}
+	if data < 0 && len(self.NegativeBarColors) > 0 {
+		return SelectColor(self.NegativeBarColors, i)
+	}
+	return SelectColor(self.BarColors, i)
+}
+
+// shortenLabel shortens a label to width with the LabelShortener. If there is none,
+// the label is trimmed if trim is set.
+func (self *BarChart) shortenLabel(label string, width int, trim bool) string {
+	switch {
+	case self.LabelShortener != nil:
+		return self.LabelShortener(label, width)
+	case trim:
+		return TrimString(label, width)
+	}
+	return label
+}
+
 func (self *BarChart) Draw(buf *Buffer) {
 	self.Block.Draw(buf)
+	self.drawScrollIndicator(buf)
+
+	if len(self.Series) > 0 {
+		self.drawGrouped(buf)
+		return
+	}
 
 	maxVal := self.MaxVal
 	if maxVal == 0 {
 		maxVal, _ = GetMaxFloat64FromSlice(self.Data)
 	}
 
+	minVal, _ := GetMinFloat64FromSlice(self.Data)
+	minVal = MinFloat64(minVal, 0)
+
+	if self.Horizontal {
+		self.drawHorizontal(buf, minVal, maxVal)
+		return
+	}
+	if minVal < 0 {
+		self.drawWithNegatives(buf, minVal, MaxFloat64(maxVal, 0))
+		return
+	}
+
+	// all values are zero, so the bars are empty
+	if maxVal <= 0 {
+		maxVal = 1
+	}
+
 	barXCoordinate := self.Inner.Min.X
 
-	for i, data := range self.Data {
+	first, count := self.visibleBars()
+	for i := first; i < first+count; i++ {
+		data := self.Data[i]
 		// draw bar
-		height := int((data / maxVal) * float64(self.Inner.Dy()-1))
+		height := scaledCells((data/maxVal)*float64(self.Inner.Dy()-1), self.Inner.Dy()-1)
 		for x := barXCoordinate; x < MinInt(barXCoordinate+self.BarWidth, self.Inner.Max.X); x++ {
 			for y := self.Inner.Max.Y - 2; y > (self.Inner.Max.Y-2)-height; y-- {
-				c := NewCell(' ', NewStyle(ColorClear, SelectColor(self.BarColors, i)))
+				c := NewCell(' ', NewStyle(ColorClear, self.barColor(i, data)))
 				buf.SetCell(c, image.Pt(x, y))
 			}
 		}
 
 		// draw label
 		if i < len(self.Labels) {
+			label := self.shortenLabel(self.Labels[i], self.BarWidth+self.BarGap, false)
 			labelXCoordinate := barXCoordinate +
 				int((float64(self.BarWidth) / 2)) -
-				int((float64(rw.StringWidth(self.Labels[i])) / 2))
+				int((float64(rw.StringWidth(label)) / 2))
 			buf.SetString(
-				self.Labels[i],
-				SelectStyle(self.LabelStyles, i),
+				label,
+				self.labelStyle(i),
 				image.Pt(labelXCoordinate, self.Inner.Max.Y-1),
 			)
 		}
@@ -77,7 +166,7 @@ func (self *BarChart) Draw(buf *Buffer) {
 				self.NumFormatter(data),
 				NewStyle(
 					SelectStyle(self.NumStyles, i+1).Fg,
-					SelectColor(self.BarColors, i),
+					self.barColor(i, data),
 					SelectStyle(self.NumStyles, i+1).Modifier,
 				),
 				image.Pt(numberXCoordinate, self.Inner.Max.Y-2),
@@ -0,0 +1,93 @@
+package widgets
+
+import (
+	"image"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// drawGrouped draws a group of adjacent bars per label, one bar per series in the color
+// of the series, with a legend of the SeriesNames in the first line. The bars of the
+// SelectedBar group are drawn in SelectedBarColor. The bars are always vertical,
+// regardless of Horizontal.
+func (self *BarChart) drawGrouped(buf *Buffer) {
+	maxVal := self.MaxVal
+	if maxVal == 0 {
+		maxVal, _ = GetMaxFloat64From2dSlice(self.Series)
+	}
+	// all values are zero or negative, so the bars are empty
+	if maxVal <= 0 {
+		maxVal = 1
+	}
+
+	top := self.Inner.Min.Y
+	if len(self.SeriesNames) > 0 {
+		self.drawLegend(buf)
+		top++
+	}
+	// the last line holds the labels
+	rows := self.Inner.Max.Y - 1 - top
+
+	groupWidth := self.BarWidth * len(self.Series)
+
+	groupXCoordinate := self.Inner.Min.X
+	first, count := self.visibleBars()
+	for i := first; i < first+count; i++ {
+		for s, series := range self.Series {
+			if i >= len(series) {
+				continue
+			}
+			barXCoordinate := groupXCoordinate + s*self.BarWidth
+			color := SelectColor(self.BarColors, s)
+			if self.Selectable && i == self.SelectedBar {
+				color = self.SelectedBarColor
+			}
+
+			// draw bar
+			height := scaledCells((MaxFloat64(series[i], 0)/maxVal)*float64(rows), rows)
+			buf.Fill(
+				NewCell(' ', NewStyle(ColorClear, color)),
+				image.Rect(barXCoordinate, self.Inner.Max.Y-1-height, MinInt(barXCoordinate+self.BarWidth, self.Inner.Max.X), self.Inner.Max.Y-1),
+			)
+
+			// draw number
+			number := self.NumFormatter(series[i])
+			if rw.StringWidth(number) <= self.BarWidth && height > 0 {
+				buf.SetString(
+					number,
+					NewStyle(SelectStyle(self.NumStyles, s+1).Fg, color, SelectStyle(self.NumStyles, s+1).Modifier),
+					image.Pt(barXCoordinate, self.Inner.Max.Y-2),
+				)
+			}
+		}
+
+		// draw label
+		if i < len(self.Labels) {
+			label := self.shortenLabel(self.Labels[i], groupWidth+self.BarGap, true)
+			buf.SetString(
+				label,
+				self.labelStyle(i),
+				image.Pt(groupXCoordinate+(groupWidth-rw.StringWidth(label))/2, self.Inner.Max.Y-1),
+			)
+		}
+
+		groupXCoordinate += groupWidth + self.BarGap
+	}
+}
+
+// drawLegend draws the SeriesNames with the colors of their bars in the first line,
+// and registers a "legend" hit region with the index of the series for each of them.
+func (self *BarChart) drawLegend(buf *Buffer) {
+	x := self.Inner.Min.X
+	for s, name := range self.SeriesNames {
+		if x >= self.Inner.Max.X {
+			break
+		}
+		buf.SetCell(NewCell(SHADED_BLOCKS[4], NewStyle(SelectColor(self.BarColors, s))), image.Pt(x, self.Inner.Min.Y))
+		name = TrimString(name, self.Inner.Max.X-x-2)
+		buf.SetString(name, SelectStyle(self.LabelStyles, s), image.Pt(x+2, self.Inner.Min.Y))
+		buf.AddHitRegion(self, "legend", image.Rect(x, self.Inner.Min.Y, x+2+rw.StringWidth(name), self.Inner.Min.Y+1), s)
+		x += rw.StringWidth(name) + 4
+	}
+}
@@ -0,0 +1,89 @@
+package widgets
+
+import (
+	"image"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// drawHorizontal draws the bars from left to right, with the labels on the left.
+// BarWidth is the height of the bars and BarGap the number of lines between them.
+// If minVal is negative, the bars extend from a vertical zero baseline.
+func (self *BarChart) drawHorizontal(buf *Buffer, minVal, maxVal float64) {
+	// the labels take up to a third of the width
+	labelWidth := 0
+	for _, label := range self.Labels {
+		labelWidth = MaxInt(labelWidth, rw.StringWidth(label))
+	}
+	labelWidth = MinInt(labelWidth, self.Inner.Dx()/3)
+	barX := self.Inner.Min.X
+	if labelWidth > 0 {
+		barX += labelWidth + 1
+	}
+
+	width := self.Inner.Max.X - barX
+	scale := float64(width) / valueRange(minVal, MaxFloat64(maxVal, 0))
+	zeroX := barX + scaledCells(-minVal*scale, width)
+	if minVal < 0 {
+		for y := self.Inner.Min.Y; y < self.Inner.Max.Y; y++ {
+			buf.SetCell(NewCell(VERTICAL_LINE, self.BaselineStyle), image.Pt(zeroX, y))
+		}
+	}
+
+	barYCoordinate := self.Inner.Min.Y
+	first, count := self.visibleBars()
+	for i := first; i < first+count; i++ {
+		data := self.Data[i]
+		if barYCoordinate >= self.Inner.Max.Y {
+			break
+		}
+		barMaxY := MinInt(barYCoordinate+self.BarWidth, self.Inner.Max.Y)
+		middleY := barYCoordinate + (barMaxY-barYCoordinate-1)/2
+
+		// draw bar
+		color := self.barColor(i, data)
+		length := scaledCells(data*scale, width)
+		minX, maxX := zeroX, zeroX+length
+		if data < 0 {
+			minX, maxX = zeroX+length, zeroX
+		}
+		buf.Fill(
+			NewCell(' ', NewStyle(ColorClear, color)),
+			image.Rect(minX, barYCoordinate, maxX, barMaxY),
+		)
+
+		// draw label
+		if i < len(self.Labels) && labelWidth > 0 {
+			label := self.shortenLabel(self.Labels[i], labelWidth, true)
+			buf.SetString(
+				label,
+				self.labelStyle(i),
+				image.Pt(barX-1-rw.StringWidth(label), middleY),
+			)
+		}
+
+		// draw number inside the end of the bar, or behind it if the bar is too short
+		number := self.NumFormatter(data)
+		numberWidth := rw.StringWidth(number)
+		numberStyle := NewStyle(
+			SelectStyle(self.NumStyles, i+1).Fg,
+			color,
+			SelectStyle(self.NumStyles, i+1).Modifier,
+		)
+		numberXCoordinate := maxX - numberWidth
+		if data < 0 {
+			numberXCoordinate = minX
+		}
+		if maxX-minX < numberWidth {
+			numberStyle = self.labelStyle(i)
+			numberXCoordinate = maxX + 1
+			if data < 0 {
+				numberXCoordinate = minX - 1 - numberWidth
+			}
+		}
+		buf.SetString(number, numberStyle, image.Pt(numberXCoordinate, middleY))
+
+		barYCoordinate += self.BarWidth + self.BarGap
+	}
+}
@@ -0,0 +1,88 @@
+package widgets
+
+import (
+	"image"
+	"math"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// valueRange returns the range of values spanned by the bars, which is at least 1 so that
+// it can be divided by.
+func valueRange(minVal, maxVal float64) float64 {
+	if valueRange := maxVal - minVal; valueRange > 0 {
+		return valueRange
+	}
+	return 1
+}
+
+// scaledCells converts a scaled value to a number of cells within limit in either direction.
+// NaN, e.g. from infinite values, becomes 0.
+func scaledCells(value float64, limit int) int {
+	if math.IsNaN(value) {
+		return 0
+	}
+	return int(math.Max(math.Min(value, float64(limit)), float64(-limit)))
+}
+
+// drawWithNegatives draws the bars from a zero baseline, which holds the numbers,
+// with positive bars extending upward and negative bars downward.
+func (self *BarChart) drawWithNegatives(buf *Buffer, minVal, maxVal float64) {
+	// the last line holds the labels
+	rows := self.Inner.Dy() - 1
+	scale := float64(rows-1) / valueRange(minVal, maxVal)
+	baselineY := self.Inner.Min.Y + scaledCells(maxVal*scale, rows-1)
+
+	for x := self.Inner.Min.X; x < self.Inner.Max.X; x++ {
+		buf.SetCell(NewCell(HORIZONTAL_LINE, self.BaselineStyle), image.Pt(x, baselineY))
+	}
+
+	barXCoordinate := self.Inner.Min.X
+	first, count := self.visibleBars()
+	for i := first; i < first+count; i++ {
+		data := self.Data[i]
+		color := self.barColor(i, data)
+		barMaxX := MinInt(barXCoordinate+self.BarWidth, self.Inner.Max.X)
+
+		// draw bar, including its part of the baseline
+		length := scaledCells(data*scale, rows)
+		minY, maxY := baselineY-length, baselineY+1
+		if data < 0 {
+			minY, maxY = baselineY, baselineY-length+1
+		}
+		buf.Fill(
+			NewCell(' ', NewStyle(ColorClear, color)),
+			image.Rect(barXCoordinate, minY, barMaxX, maxY),
+		)
+
+		// draw label
+		if i < len(self.Labels) {
+			label := self.shortenLabel(self.Labels[i], self.BarWidth+self.BarGap, false)
+			labelXCoordinate := barXCoordinate +
+				int((float64(self.BarWidth) / 2)) -
+				int((float64(rw.StringWidth(label)) / 2))
+			buf.SetString(
+				label,
+				self.labelStyle(i),
+				image.Pt(labelXCoordinate, self.Inner.Max.Y-1),
+			)
+		}
+
+		// draw number
+		numberXCoordinate := barXCoordinate + int((float64(self.BarWidth) / 2))
+		if numberXCoordinate <= self.Inner.Max.X {
+			buf.SetString(
+				self.NumFormatter(data),
+				NewStyle(
+					SelectStyle(self.NumStyles, i+1).Fg,
+					color,
+					SelectStyle(self.NumStyles, i+1).Modifier,
+				),
+				image.Pt(numberXCoordinate, baselineY),
+			)
+		}
+
+		barXCoordinate += (self.BarWidth + self.BarGap)
+	}
+}
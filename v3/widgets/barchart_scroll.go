@@ -0,0 +1,78 @@
+package widgets
+
+import (
+	"fmt"
+	"image"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// barCount returns the number of bars, or groups of bars for Series.
+func (self *BarChart) barCount() int {
+	if len(self.Series) == 0 {
+		return len(self.Data)
+	}
+	count := 0
+	for _, series := range self.Series {
+		count = MaxInt(count, len(series))
+	}
+	return count
+}
+
+// pageSize returns the number of bars that fit into the widget.
+func (self *BarChart) pageSize() int {
+	space, barWidth := self.Inner.Dx(), self.BarWidth
+	if self.Horizontal {
+		space = self.Inner.Dy()
+	}
+	if len(self.Series) > 0 {
+		barWidth *= len(self.Series)
+	}
+	return MaxInt((space+self.BarGap)/MaxInt(barWidth+self.BarGap, 1), 1)
+}
+
+// visibleBars clamps the Offset and returns the index of the first bar shown and the number of bars shown.
+func (self *BarChart) visibleBars() (int, int) {
+	total, page := self.barCount(), self.pageSize()
+	self.Offset = MaxInt(MinInt(self.Offset, total-page), 0)
+	return self.Offset, MinInt(page, total-self.Offset)
+}
+
+// ScrollAmount moves the Offset by amount bars. If amount is < 0, it scrolls to the left
+// or, in Horizontal mode, up.
+func (self *BarChart) ScrollAmount(amount int) {
+	self.Offset += amount
+	self.visibleBars()
+}
+
+func (self *BarChart) ScrollLeft() {
+	self.ScrollAmount(-1)
+}
+
+func (self *BarChart) ScrollRight() {
+	self.ScrollAmount(1)
+}
+
+func (self *BarChart) ScrollPageLeft() {
+	self.ScrollAmount(-self.pageSize())
+}
+
+func (self *BarChart) ScrollPageRight() {
+	self.ScrollAmount(self.pageSize())
+}
+
+// drawScrollIndicator shows the range of the visible bars in the bottom border
+// if not all bars fit.
+func (self *BarChart) drawScrollIndicator(buf *Buffer) {
+	first, count := self.visibleBars()
+	total := self.barCount()
+	if count >= total || !self.Border {
+		return
+	}
+	indicator := fmt.Sprintf("%c %d-%d/%d %c", QUOTA_LEFT, first+1, first+count, total, QUOTA_RIGHT)
+	x := self.Max.X - 2 - rw.StringWidth(indicator)
+	if x > self.Min.X {
+		buf.SetString(indicator, self.TitleStyle, image.Pt(x, self.Max.Y-1))
+	}
+}
@@ -0,0 +1,50 @@
+package widgets
+
+import (
+	. "github.com/s-westphal/termui/v3"
+)
+
+// labelStyle returns the style of the label of bar i, which depends on the selection.
+func (self *BarChart) labelStyle(i int) Style {
+	if self.Selectable && i == self.SelectedBar {
+		return self.SelectedLabelStyle
+	}
+	return SelectStyle(self.LabelStyles, i)
+}
+
+// Select selects bar index, scrolls it into view and calls OnSelect if the selection changed.
+func (self *BarChart) Select(index int) {
+	if !self.Selectable || self.barCount() == 0 {
+		return
+	}
+	index = MaxInt(MinInt(index, self.barCount()-1), 0)
+	if first, count := self.visibleBars(); index < first {
+		self.Offset = index
+	} else if index >= first+count {
+		self.Offset = index - count + 1
+	}
+	if index == self.SelectedBar {
+		return
+	}
+	self.SelectedBar = index
+	if self.OnSelect != nil {
+		self.OnSelect(index)
+	}
+}
+
+// SelectNext selects the bar to the right, or below in Horizontal mode.
+func (self *BarChart) SelectNext() {
+	self.Select(self.SelectedBar + 1)
+}
+
+// SelectPrevious selects the bar to the left, or above in Horizontal mode.
+func (self *BarChart) SelectPrevious() {
+	self.Select(self.SelectedBar - 1)
+}
+
+// Activate calls OnActivate with the SelectedBar.
+func (self *BarChart) Activate() {
+	if self.Selectable && self.OnActivate != nil && self.SelectedBar < self.barCount() {
+		self.OnActivate(self.SelectedBar)
+	}
+}
@@ -0,0 +1,105 @@
+package widgets
+
+import (
+	"image"
+	"time"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// buttonPressDuration is how long a Button activated with the keyboard is drawn pressed.
+const buttonPressDuration = 150 * time.Millisecond
+
+// Button is a centered label that calls OnClick when it is activated with <Enter>, <Space>
+// or a click, see HandleEvent. It is drawn in FocusedStyle while Focused, e.g. set from
+// FocusManager.OnFocusChange, and in PressedStyle while it is pressed.
+type Button struct {
+	Block
+	Label        string
+	TextStyle    Style
+	FocusedStyle Style
+	PressedStyle Style
+	Focused      bool
+
+	OnClick func()
+
+	// pressed is set while the left mouse button is held down on the Button.
+	pressed      bool
+	pressedUntil time.Time
+}
+
+func NewButton(label string) *Button {
+	return &Button{
+		Block:        *NewBlock(),
+		Label:        label,
+		TextStyle:    Theme.Button.Text,
+		FocusedStyle: Theme.Button.Focused,
+		PressedStyle: Theme.Button.Pressed,
+	}
+}
+
+// Click calls OnClick and draws the Button pressed for a moment.
+func (self *Button) Click() {
+	self.pressedUntil = DefaultClock.Now().Add(buttonPressDuration)
+	if self.OnClick != nil {
+		self.OnClick()
+	}
+}
+
+// IsPressed reports whether the Button is drawn pressed.
+func (self *Button) IsPressed() bool {
+	return self.pressed || DefaultClock.Now().Before(self.pressedUntil)
+}
+
+func (self *Button) Draw(buf *Buffer) {
+	self.Block.Draw(buf)
+
+	style := self.TextStyle
+	switch {
+	case self.IsPressed():
+		style = self.PressedStyle
+	case self.Focused:
+		style = self.FocusedStyle
+	}
+	buf.Fill(NewCell(' ', style), self.Inner)
+
+	label := TrimString(self.Label, self.Inner.Dx())
+	buf.SetString(label, style, image.Pt(
+		self.Inner.Min.X+(self.Inner.Dx()-rw.StringWidth(label))/2,
+		self.Inner.Min.Y+(self.Inner.Dy()-1)/2,
+	))
+}
+
+// HandleEvent clicks the Button with <Enter> and <Space>, or when the left mouse button
+// is pressed and released on it. It returns whether the event was used.
+func (self *Button) HandleEvent(e Event) bool {
+	switch e.Type {
+	case KeyboardEvent:
+		if e.ID == "<Enter>" || e.ID == "<Space>" {
+			self.Click()
+			return true
+		}
+	case MouseEvent:
+		mouse, ok := e.Payload.(Mouse)
+		if !ok {
+			return false
+		}
+		inside := image.Pt(mouse.X, mouse.Y).In(self.GetRect())
+		switch e.ID {
+		case "<MouseLeft>":
+			self.pressed = inside || (mouse.Drag && self.pressed)
+			return self.pressed
+		case "<MouseRelease>":
+			if !self.pressed {
+				return false
+			}
+			self.pressed = false
+			if inside {
+				self.Click()
+			}
+			return true
+		}
+	}
+	return false
+}
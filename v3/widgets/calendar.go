@@ -0,0 +1,274 @@
+package widgets
+
+import (
+	"fmt"
+	"image"
+	"time"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+const (
+	// calendarWidth is the width of the 7 day columns of two cells and the gaps between them.
+	calendarWidth = 7*3 - 1
+	// calendarHeaderRows are the rows of the month title and the weekday names.
+	calendarHeaderRows = 2
+)
+
+// Calendar shows the month of its Date, which is the cursor moved by the keyboard and
+// mouse, see HandleEvent. With RangeSelect, the first selection sets the start of a range
+// of dates and the second the end.
+type Calendar struct {
+	Block
+	Date time.Time
+	// FirstWeekday is the weekday of the first column.
+	FirstWeekday time.Weekday
+
+	// Highlights are the styles of dates, keyed by the date in DateFormat.
+	Highlights map[string]Style
+
+	RangeSelect bool
+
+	HeaderStyle    Style
+	TextStyle      Style
+	CursorStyle    Style
+	RangeStyle     Style
+	TodayStyle     Style
+	HighlightStyle Style
+
+	// OnSelect is called with the selected date, OnRangeSelect when a range is completed.
+	OnSelect      func(date time.Time)
+	OnRangeSelect func(start, end time.Time)
+
+	selected   time.Time
+	rangeStart time.Time
+	rangeEnd   time.Time
+	// selecting is set after the start of a range was selected.
+	selecting bool
+}
+
+func NewCalendar() *Calendar {
+	return &Calendar{
+		Block:          *NewBlock(),
+		Date:           startOfDay(DefaultClock.Now()),
+		Highlights:     make(map[string]Style),
+		HeaderStyle:    Theme.Calendar.Header,
+		TextStyle:      Theme.Calendar.Text,
+		CursorStyle:    Theme.Calendar.Cursor,
+		RangeStyle:     Theme.Calendar.Range,
+		TodayStyle:     Theme.Calendar.Today,
+		HighlightStyle: Theme.Calendar.Highlight,
+	}
+}
+
+// Highlight draws date in the HighlightStyle.
+func (self *Calendar) Highlight(date time.Time) {
+	self.Highlights[date.Format(DateFormat)] = self.HighlightStyle
+}
+
+// MoveDays moves the Date by days, which may be negative.
+func (self *Calendar) MoveDays(days int) {
+	self.Date = startOfDay(self.Date).AddDate(0, 0, days)
+}
+
+// MoveMonths moves the Date by months, keeping the day unless the month is shorter.
+func (self *Calendar) MoveMonths(months int) {
+	date := startOfDay(self.Date)
+	first := time.Date(date.Year(), date.Month()+time.Month(months), 1, 0, 0, 0, 0, date.Location())
+	self.Date = first.AddDate(0, 0, MinInt(date.Day(), daysIn(first))-1)
+}
+
+// MoveYears moves the Date by years, keeping the day unless the month is shorter.
+func (self *Calendar) MoveYears(years int) {
+	self.MoveMonths(12 * years)
+}
+
+// Select selects the Date and calls OnSelect. With RangeSelect, it starts a range, or
+// completes it and calls OnRangeSelect.
+func (self *Calendar) Select() {
+	date := startOfDay(self.Date)
+	self.selected = date
+	if self.RangeSelect {
+		if !self.selecting {
+			self.rangeStart, self.rangeEnd = date, date
+			self.selecting = true
+		} else {
+			self.rangeStart, self.rangeEnd = self.orderedRange(date)
+			self.selecting = false
+			if self.OnRangeSelect != nil {
+				self.OnRangeSelect(self.rangeStart, self.rangeEnd)
+			}
+		}
+	}
+	if self.OnSelect != nil {
+		self.OnSelect(date)
+	}
+}
+
+// orderedRange returns the range from the rangeStart to date in chronological order.
+func (self *Calendar) orderedRange(date time.Time) (time.Time, time.Time) {
+	if date.Before(self.rangeStart) {
+		return date, self.rangeStart
+	}
+	return self.rangeStart, date
+}
+
+// Selected returns the last selected date, or the zero time if none was selected.
+func (self *Calendar) Selected() time.Time {
+	return self.selected
+}
+
+// SelectedRange returns the selected range of dates including both ends, and false if no
+// range was completed.
+func (self *Calendar) SelectedRange() (time.Time, time.Time, bool) {
+	if self.rangeStart.IsZero() || self.selecting {
+		return time.Time{}, time.Time{}, false
+	}
+	return self.rangeStart, self.rangeEnd, true
+}
+
+// ClearSelection forgets the selected date and range.
+func (self *Calendar) ClearSelection() {
+	self.selected, self.rangeStart, self.rangeEnd = time.Time{}, time.Time{}, time.Time{}
+	self.selecting = false
+}
+
+// inRange reports whether date is within the selected range, or within the range being
+// selected, which ends at the Date.
+func (self *Calendar) inRange(date time.Time) bool {
+	start, end := self.rangeStart, self.rangeEnd
+	if self.selecting {
+		start, end = self.orderedRange(startOfDay(self.Date))
+	}
+	return !start.IsZero() && !date.Before(start) && !date.After(end)
+}
+
+// origin returns the top left corner of the day grid, which is centered horizontally.
+func (self *Calendar) origin() image.Point {
+	return image.Pt(
+		self.Inner.Min.X+MaxInt(self.Inner.Dx()-calendarWidth, 0)/2,
+		self.Inner.Min.Y+calendarHeaderRows,
+	)
+}
+
+// firstCell returns the date of the first cell of the grid and the column of the first
+// day of the month.
+func (self *Calendar) firstCell() (time.Time, int) {
+	date := startOfDay(self.Date)
+	first := date.AddDate(0, 0, 1-date.Day())
+	column := (int(first.Weekday()) - int(self.FirstWeekday) + 7) % 7
+	return first.AddDate(0, 0, -column), column
+}
+
+// dateAt returns the date of the day of the shown month at p, and false if there is none.
+func (self *Calendar) dateAt(p image.Point) (time.Time, bool) {
+	origin := self.origin()
+	if p.X < origin.X || p.Y < origin.Y || p.X >= origin.X+calendarWidth || !p.In(self.Inner) {
+		return time.Time{}, false
+	}
+	column, row := (p.X-origin.X)/3, p.Y-origin.Y
+	start, _ := self.firstCell()
+	date := start.AddDate(0, 0, row*7+column)
+	return date, date.Month() == self.Date.Month()
+}
+
+func (self *Calendar) Draw(buf *Buffer) {
+	self.Block.Draw(buf)
+
+	origin := self.origin()
+	title := TrimString(fmt.Sprintf("%s %d", self.Date.Month(), self.Date.Year()), self.Inner.Dx())
+	buf.SetString(title, self.HeaderStyle, image.Pt(
+		self.Inner.Min.X+MaxInt(self.Inner.Dx()-rw.StringWidth(title), 0)/2,
+		self.Inner.Min.Y,
+	))
+	for column := 0; column < 7; column++ {
+		weekday := time.Weekday((int(self.FirstWeekday) + column) % 7)
+		buf.SetString(weekday.String()[:2], self.HeaderStyle, image.Pt(origin.X+column*3, self.Inner.Min.Y+1))
+	}
+
+	today := startOfDay(DefaultClock.Now())
+	cursor := startOfDay(self.Date)
+	start, column := self.firstCell()
+	days := daysIn(cursor)
+	for day := 1; day <= days; day++ {
+		cell := column + day - 1
+		point := origin.Add(image.Pt(cell%7*3, cell/7))
+		if point.Y >= self.Inner.Max.Y {
+			break
+		}
+		date := start.AddDate(0, 0, cell)
+		style := self.TextStyle
+		if highlight, ok := self.Highlights[date.Format(DateFormat)]; ok {
+			style = highlight
+		}
+		if date.Equal(today) {
+			style.Modifier |= self.TodayStyle.Modifier
+		}
+		if self.RangeSelect && self.inRange(date) {
+			style.Fg, style.Bg = self.RangeStyle.Fg, self.RangeStyle.Bg
+		}
+		if date.Equal(cursor) {
+			style.Fg, style.Bg = self.CursorStyle.Fg, self.CursorStyle.Bg
+		}
+		buf.SetString(fmt.Sprintf("%2d", day), style, point)
+	}
+}
+
+// HandleEvent moves the Date by a day with <Left> and <Right>, by a week with <Up> and
+// <Down>, by a month with <PageUp> and <PageDown> and by a year with <M-<PageUp>> and
+// <M-<PageDown>>. <Home> and <End> move to the first and last day of the month and "t"
+// to today. <Enter>, <Space> and clicks on days select them.
+// It returns whether the event was used.
+func (self *Calendar) HandleEvent(e Event) bool {
+	switch e.Type {
+	case KeyboardEvent:
+		switch e.ID {
+		case "<Left>", "h":
+			self.MoveDays(-1)
+		case "<Right>", "l":
+			self.MoveDays(1)
+		case "<Up>", "k":
+			self.MoveDays(-7)
+		case "<Down>", "j":
+			self.MoveDays(7)
+		case "<PageUp>":
+			self.MoveMonths(-1)
+		case "<PageDown>":
+			self.MoveMonths(1)
+		case "<M-<PageUp>>":
+			self.MoveYears(-1)
+		case "<M-<PageDown>>":
+			self.MoveYears(1)
+		case "<Home>":
+			self.MoveDays(1 - self.Date.Day())
+		case "<End>":
+			self.MoveDays(daysIn(self.Date) - self.Date.Day())
+		case "t":
+			self.Date = startOfDay(DefaultClock.Now())
+		case "<Enter>", "<Space>":
+			self.Select()
+		default:
+			return false
+		}
+		return true
+	case MouseEvent:
+		mouse, ok := e.Payload.(Mouse)
+		if !ok || e.ID != "<MouseLeft>" || mouse.Drag {
+			return false
+		}
+		date, ok := self.dateAt(image.Pt(mouse.X, mouse.Y))
+		if !ok {
+			return false
+		}
+		self.Date = date
+		self.Select()
+		return true
+	}
+	return false
+}
+
+// FormValue returns the selected date, see Form.
+func (self *Calendar) FormValue() interface{} {
+	return self.Selected()
+}
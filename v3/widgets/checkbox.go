@@ -0,0 +1,86 @@
+package widgets
+
+import (
+	"image"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// Checkbox is a labeled box that can be checked with <Space> or a click, see HandleEvent.
+type Checkbox struct {
+	Block
+	Label     string
+	Checked   bool
+	TextStyle Style
+
+	// Indeterminate shows the Checkbox as neither checked nor unchecked, e.g. for a Checkbox
+	// that checks a group of others. Toggling it clears the state and checks the Checkbox.
+	Indeterminate bool
+
+	// OnChange is called with the new state when the Checkbox is toggled.
+	OnChange func(checked bool)
+}
+
+func NewCheckbox(label string) *Checkbox {
+	return &Checkbox{
+		Block:     *NewBlock(),
+		Label:     label,
+		TextStyle: Theme.Form.Input,
+	}
+}
+
+// Toggle checks or unchecks the Checkbox and calls OnChange. An Indeterminate Checkbox
+// becomes checked.
+func (self *Checkbox) Toggle() {
+	self.Checked = !self.Checked || self.Indeterminate
+	self.Indeterminate = false
+	if self.OnChange != nil {
+		self.OnChange(self.Checked)
+	}
+}
+
+func (self *Checkbox) Draw(buf *Buffer) {
+	self.Block.Draw(buf)
+
+	buf.SetString(
+		TrimString(self.text(), self.Inner.Dx()),
+		self.TextStyle,
+		image.Pt(self.Inner.Min.X, self.Inner.Min.Y),
+	)
+}
+
+// text returns the box with the state and the Label.
+func (self *Checkbox) text() string {
+	switch {
+	case self.Indeterminate:
+		return treeCheckboxes[TreePartiallyChecked] + self.Label
+	case self.Checked:
+		return listChecked + self.Label
+	}
+	return listUnchecked + self.Label
+}
+
+// HandleEvent toggles the Checkbox with <Space>, <Enter> and left clicks. It returns whether
+// the event was used.
+func (self *Checkbox) HandleEvent(e Event) bool {
+	switch e.Type {
+	case KeyboardEvent:
+		if e.ID != "<Space>" && e.ID != "<Enter>" {
+			return false
+		}
+	case MouseEvent:
+		mouse, ok := e.Payload.(Mouse)
+		if !ok || e.ID != "<MouseLeft>" || mouse.Drag || !image.Pt(mouse.X, mouse.Y).In(self.Inner) {
+			return false
+		}
+	default:
+		return false
+	}
+	self.Toggle()
+	return true
+}
+
+// FormValue returns whether the Checkbox is checked, see Form.
+func (self *Checkbox) FormValue() interface{} {
+	return self.Checked
+}
@@ -0,0 +1,178 @@
+package widgets
+
+import (
+	"image"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// CheckboxGroup shows a Checkbox per row, of which the SelectedRow is toggled with <Space>
+// or <Enter> and moved with <Up> and <Down>, see HandleEvent. With ToggleAll, the first row
+// checks or unchecks all Checkboxes and is Indeterminate if only some are checked.
+type CheckboxGroup struct {
+	Block
+	Checkboxes       []*Checkbox
+	SelectedRow      int
+	TextStyle        Style
+	SelectedRowStyle Style
+
+	ToggleAll      bool
+	ToggleAllLabel string
+
+	// OnChange is called with the index and state of a toggled Checkbox. Toggling all
+	// Checkboxes calls it for every Checkbox whose state changed.
+	OnChange func(index int, checked bool)
+}
+
+func NewCheckboxGroup(labels ...string) *CheckboxGroup {
+	checkboxes := make([]*Checkbox, len(labels))
+	for i, label := range labels {
+		checkboxes[i] = NewCheckbox(label)
+	}
+	return &CheckboxGroup{
+		Block:            *NewBlock(),
+		Checkboxes:       checkboxes,
+		TextStyle:        Theme.Form.Input,
+		SelectedRowStyle: NewStyle(Theme.Form.Input.Fg, Theme.Form.Input.Bg, ModifierReverse),
+		ToggleAllLabel:   "All",
+	}
+}
+
+// Checked returns the indices of the checked Checkboxes.
+func (self *CheckboxGroup) Checked() []int {
+	checked := []int{}
+	for i, checkbox := range self.Checkboxes {
+		if checkbox.Checked {
+			checked = append(checked, i)
+		}
+	}
+	return checked
+}
+
+// CheckedLabels returns the labels of the checked Checkboxes.
+func (self *CheckboxGroup) CheckedLabels() []string {
+	labels := []string{}
+	for _, i := range self.Checked() {
+		labels = append(labels, self.Checkboxes[i].Label)
+	}
+	return labels
+}
+
+// toggleAllCheckbox returns the Checkbox of the ToggleAll row, which reflects the
+// state of the others.
+func (self *CheckboxGroup) toggleAllCheckbox() *Checkbox {
+	checked := len(self.Checked())
+	return &Checkbox{
+		Label:         self.ToggleAllLabel,
+		Checked:       checked > 0 && checked == len(self.Checkboxes),
+		Indeterminate: checked > 0 && checked < len(self.Checkboxes),
+	}
+}
+
+// rows returns the Checkboxes shown in the rows, starting with the ToggleAll row.
+func (self *CheckboxGroup) rows() []*Checkbox {
+	if !self.ToggleAll {
+		return self.Checkboxes
+	}
+	return append([]*Checkbox{self.toggleAllCheckbox()}, self.Checkboxes...)
+}
+
+// ToggleRow toggles the Checkbox in row, or all Checkboxes in the ToggleAll row.
+func (self *CheckboxGroup) ToggleRow(row int) {
+	if self.ToggleAll {
+		if row == 0 {
+			self.SetAll(!self.toggleAllCheckbox().Checked)
+			return
+		}
+		row--
+	}
+	if row < 0 || row >= len(self.Checkboxes) {
+		return
+	}
+	self.Checkboxes[row].Toggle()
+	if self.OnChange != nil {
+		self.OnChange(row, self.Checkboxes[row].Checked)
+	}
+}
+
+// ToggleSelected toggles the Checkbox in the SelectedRow.
+func (self *CheckboxGroup) ToggleSelected() {
+	self.ToggleRow(self.SelectedRow)
+}
+
+// SetAll checks or unchecks all Checkboxes.
+func (self *CheckboxGroup) SetAll(checked bool) {
+	for i, checkbox := range self.Checkboxes {
+		if checkbox.Checked != checked || checkbox.Indeterminate {
+			checkbox.Checked = checked
+			checkbox.Indeterminate = false
+			if checkbox.OnChange != nil {
+				checkbox.OnChange(checked)
+			}
+			if self.OnChange != nil {
+				self.OnChange(i, checked)
+			}
+		}
+	}
+}
+
+func (self *CheckboxGroup) SelectNext() {
+	self.SelectedRow = MinInt(self.SelectedRow+1, len(self.rows())-1)
+}
+
+func (self *CheckboxGroup) SelectPrevious() {
+	self.SelectedRow = MaxInt(self.SelectedRow-1, 0)
+}
+
+func (self *CheckboxGroup) Draw(buf *Buffer) {
+	self.Block.Draw(buf)
+
+	for i, checkbox := range self.rows() {
+		y := self.Inner.Min.Y + i
+		if y >= self.Inner.Max.Y {
+			break
+		}
+		style := self.TextStyle
+		if i == self.SelectedRow {
+			style = self.SelectedRowStyle
+		}
+		buf.SetString(TrimString(checkbox.text(), self.Inner.Dx()), style, image.Pt(self.Inner.Min.X, y))
+	}
+}
+
+// HandleEvent moves the SelectedRow with <Up> and <Down>, toggles it with <Space> and
+// <Enter> and toggles clicked rows. It returns whether the event was used.
+func (self *CheckboxGroup) HandleEvent(e Event) bool {
+	switch e.Type {
+	case KeyboardEvent:
+		switch e.ID {
+		case "<Up>":
+			self.SelectPrevious()
+		case "<Down>":
+			self.SelectNext()
+		case "<Space>", "<Enter>":
+			self.ToggleSelected()
+		default:
+			return false
+		}
+		return true
+	case MouseEvent:
+		mouse, ok := e.Payload.(Mouse)
+		if !ok || e.ID != "<MouseLeft>" || mouse.Drag || !image.Pt(mouse.X, mouse.Y).In(self.Inner) {
+			return false
+		}
+		row := mouse.Y - self.Inner.Min.Y
+		if row >= len(self.rows()) {
+			return false
+		}
+		self.SelectedRow = row
+		self.ToggleRow(row)
+		return true
+	}
+	return false
+}
+
+// FormValue returns the CheckedLabels, see Form.
+func (self *CheckboxGroup) FormValue() interface{} {
+	return self.CheckedLabels()
+}
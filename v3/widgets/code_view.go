@@ -0,0 +1,173 @@
+package widgets
+
+import (
+	"fmt"
+	"image"
+	"strings"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// CodeView shows source code with syntax highlighting by a pluggable Lexer, line numbers
+// and a highlighted current line, which is moved with ScrollUp and ScrollDown.
+type CodeView struct {
+	Block
+	Code string
+	// Lexer splits the Code into tokens, which are drawn in the TokenStyles of their kind.
+	// The Code is drawn in TextStyle if it is nil, see LexerForFilename.
+	Lexer       Lexer
+	TokenStyles map[TokenKind]Style
+	TextStyle   Style
+	TabWidth    int
+
+	ShowLineNumbers bool
+	LineNumberStyle Style
+
+	// HighlightCurrentLine draws the line with the index CurrentLine with the Bg of the
+	// CurrentLineStyle.
+	HighlightCurrentLine bool
+	CurrentLine          int
+	CurrentLineStyle     Style
+
+	// Scrollbar is drawn at the right edge if set, e.g. with NewScrollbar().
+	Scrollbar *Scrollbar
+
+	topLine int
+}
+
+func NewCodeView() *CodeView {
+	return &CodeView{
+		Block: *NewBlock(),
+		TokenStyles: map[TokenKind]Style{
+			TokenText:     Theme.CodeView.Text,
+			TokenKeyword:  Theme.CodeView.Keyword,
+			TokenString:   Theme.CodeView.String,
+			TokenComment:  Theme.CodeView.Comment,
+			TokenNumber:   Theme.CodeView.Number,
+			TokenFunction: Theme.CodeView.Function,
+		},
+		TextStyle:            Theme.CodeView.Text,
+		TabWidth:             4,
+		ShowLineNumbers:      true,
+		LineNumberStyle:      Theme.CodeView.LineNumber,
+		HighlightCurrentLine: true,
+		CurrentLineStyle:     Theme.CodeView.CurrentLine,
+	}
+}
+
+// lines returns the highlighted lines of the Code.
+func (self *CodeView) lines() [][]Cell {
+	tokens := []Token{{self.Code, TokenText}}
+	if self.Lexer != nil {
+		tokens = self.Lexer.Lex(self.Code)
+	}
+	cells := []Cell{}
+	tab := strings.Repeat(" ", self.TabWidth)
+	for _, token := range tokens {
+		style, ok := self.TokenStyles[token.Kind]
+		if !ok {
+			style = self.TextStyle
+		}
+		cells = append(cells, RunesToStyledCells([]rune(strings.Replace(token.Text, "\t", tab, -1)), style)...)
+	}
+
+	lines := [][]Cell{{}}
+	for _, cell := range cells {
+		if cell.Rune == '\n' {
+			lines = append(lines, []Cell{})
+			continue
+		}
+		lines[len(lines)-1] = append(lines[len(lines)-1], cell)
+	}
+	return lines
+}
+
+// lineCount returns the number of lines of the Code without highlighting it.
+func (self *CodeView) lineCount() int {
+	return strings.Count(self.Code, "\n") + 1
+}
+
+// gutterWidth returns the width of the line numbers including the gap to the code.
+func (self *CodeView) gutterWidth(lines int) int {
+	if !self.ShowLineNumbers {
+		return 0
+	}
+	return len(fmt.Sprint(lines)) + 1
+}
+
+// contentMaxX returns the right edge of the code, which leaves room for the Scrollbar.
+func (self *CodeView) contentMaxX() int {
+	if self.Scrollbar != nil {
+		return self.Inner.Max.X - 1
+	}
+	return self.Inner.Max.X
+}
+
+func (self *CodeView) Draw(buf *Buffer) {
+	self.Block.Draw(buf)
+
+	lines := self.lines()
+	self.CurrentLine = MaxInt(MinInt(self.CurrentLine, len(lines)-1), 0)
+
+	// adjusts view into widget
+	if self.CurrentLine >= self.topLine+self.Inner.Dy() {
+		self.topLine = self.CurrentLine - self.Inner.Dy() + 1
+	} else if self.CurrentLine < self.topLine {
+		self.topLine = self.CurrentLine
+	}
+	self.topLine = MaxInt(MinInt(self.topLine, len(lines)-self.Inner.Dy()), 0)
+
+	gutter := self.gutterWidth(len(lines))
+	for i := self.topLine; i < len(lines) && i-self.topLine < self.Inner.Dy(); i++ {
+		y := self.Inner.Min.Y + i - self.topLine
+		current := self.HighlightCurrentLine && i == self.CurrentLine
+		if current {
+			buf.Fill(NewCell(' ', self.CurrentLineStyle), image.Rect(self.Inner.Min.X+gutter, y, self.contentMaxX(), y+1))
+		}
+		if gutter > 0 {
+			number := fmt.Sprintf("%*d", gutter-1, i+1)
+			buf.SetString(number, self.LineNumberStyle, image.Pt(self.Inner.Min.X, y))
+		}
+		line := TrimCells(lines[i], self.contentMaxX()-self.Inner.Min.X-gutter)
+		for _, cx := range BuildCellWithXArray(line) {
+			cell := cx.Cell
+			if current {
+				cell.Style.Bg = self.CurrentLineStyle.Bg
+			}
+			buf.SetCell(cell, image.Pt(self.Inner.Min.X+gutter+cx.X, y))
+		}
+	}
+
+	if self.Scrollbar != nil {
+		self.Scrollbar.Draw(buf, self.Inner.Max.X-1, self.Inner.Min.Y, self.Inner.Max.Y, len(lines), self.topLine, self.Inner.Dy())
+	}
+}
+
+// ScrollAmount moves the CurrentLine by amount lines. If amount is < 0, then scroll up.
+func (self *CodeView) ScrollAmount(amount int) {
+	self.CurrentLine = MaxInt(MinInt(self.CurrentLine+amount, self.lineCount()-1), 0)
+}
+
+func (self *CodeView) ScrollUp() {
+	self.ScrollAmount(-1)
+}
+
+func (self *CodeView) ScrollDown() {
+	self.ScrollAmount(1)
+}
+
+func (self *CodeView) ScrollPageUp() {
+	self.ScrollAmount(-self.Inner.Dy())
+}
+
+func (self *CodeView) ScrollPageDown() {
+	self.ScrollAmount(self.Inner.Dy())
+}
+
+func (self *CodeView) ScrollTop() {
+	self.CurrentLine = 0
+}
+
+func (self *CodeView) ScrollBottom() {
+	self.CurrentLine = self.lineCount() - 1
+}
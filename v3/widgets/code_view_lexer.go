@@ -0,0 +1,201 @@
+package widgets
+
+import (
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// TokenKind is the syntactic category of a Token, which determines its style in a CodeView.
+type TokenKind uint
+
+const (
+	TokenText TokenKind = iota
+	TokenKeyword
+	TokenString
+	TokenComment
+	TokenNumber
+	TokenFunction
+)
+
+// Token is a piece of code. Tokens may span several lines.
+type Token struct {
+	Text string
+	Kind TokenKind
+}
+
+// Lexer splits code into tokens for syntax highlighting. The concatenated tokens must
+// equal the code.
+type Lexer interface {
+	Lex(code string) []Token
+}
+
+// SimpleLexer is a Lexer for C-like and scripting languages, which recognizes keywords,
+// comments, strings, numbers and function calls.
+type SimpleLexer struct {
+	Keywords          []string
+	LineComment       string
+	BlockCommentStart string
+	BlockCommentEnd   string
+	// StringDelimiters are the runes that start and end strings. Strings end at the end of
+	// the line, except for those in MultiLineDelimiters.
+	StringDelimiters    string
+	MultiLineDelimiters string
+}
+
+var (
+	GoLexer = &SimpleLexer{
+		Keywords: []string{
+			"break", "case", "chan", "const", "continue", "default", "defer", "else", "fallthrough",
+			"for", "func", "go", "goto", "if", "import", "interface", "map", "package", "range",
+			"return", "select", "struct", "switch", "type", "var", "nil", "true", "false", "iota",
+		},
+		LineComment:         "//",
+		BlockCommentStart:   "/*",
+		BlockCommentEnd:     "*/",
+		StringDelimiters:    "\"'`",
+		MultiLineDelimiters: "`",
+	}
+	PythonLexer = &SimpleLexer{
+		Keywords: []string{
+			"and", "as", "assert", "async", "await", "break", "class", "continue", "def", "del",
+			"elif", "else", "except", "finally", "for", "from", "global", "if", "import", "in",
+			"is", "lambda", "nonlocal", "not", "or", "pass", "raise", "return", "try", "while",
+			"with", "yield", "None", "True", "False",
+		},
+		LineComment:      "#",
+		StringDelimiters: "\"'",
+	}
+	JavaScriptLexer = &SimpleLexer{
+		Keywords: []string{
+			"async", "await", "break", "case", "catch", "class", "const", "continue", "default",
+			"delete", "do", "else", "export", "extends", "finally", "for", "function", "if",
+			"import", "in", "instanceof", "let", "new", "of", "return", "switch", "this", "throw",
+			"try", "typeof", "var", "void", "while", "yield", "null", "undefined", "true", "false",
+		},
+		LineComment:         "//",
+		BlockCommentStart:   "/*",
+		BlockCommentEnd:     "*/",
+		StringDelimiters:    "\"'`",
+		MultiLineDelimiters: "`",
+	}
+	ShellLexer = &SimpleLexer{
+		Keywords: []string{
+			"case", "do", "done", "elif", "else", "esac", "export", "fi", "for", "function", "if",
+			"in", "local", "return", "then", "until", "while",
+		},
+		LineComment:      "#",
+		StringDelimiters: "\"'",
+	}
+	JSONLexer = &SimpleLexer{
+		Keywords:         []string{"true", "false", "null"},
+		StringDelimiters: "\"",
+	}
+)
+
+// LexerForFilename returns the built-in Lexer for the extension of filename, or nil if there is none.
+func LexerForFilename(filename string) Lexer {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".go":
+		return GoLexer
+	case ".py":
+		return PythonLexer
+	case ".js", ".jsx", ".ts", ".tsx":
+		return JavaScriptLexer
+	case ".sh", ".bash", ".zsh":
+		return ShellLexer
+	case ".json":
+		return JSONLexer
+	}
+	return nil
+}
+
+func (self *SimpleLexer) Lex(code string) []Token {
+	tokens := []Token{}
+	runes := []rune(code)
+	for i := 0; i < len(runes); {
+		start := i
+		kind := TokenText
+		switch r := runes[i]; {
+		case hasRunePrefix(runes[i:], self.LineComment):
+			kind = TokenComment
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case hasRunePrefix(runes[i:], self.BlockCommentStart):
+			kind = TokenComment
+			i += len([]rune(self.BlockCommentStart))
+			for i < len(runes) && !hasRunePrefix(runes[i:], self.BlockCommentEnd) {
+				i++
+			}
+			i = MinInt(i+len([]rune(self.BlockCommentEnd)), len(runes))
+		case strings.ContainsRune(self.StringDelimiters, r):
+			kind = TokenString
+			multiLine := strings.ContainsRune(self.MultiLineDelimiters, r)
+			for i++; i < len(runes) && runes[i] != r && (multiLine || runes[i] != '\n'); i++ {
+				if runes[i] == '\\' && i+1 < len(runes) && runes[i+1] != '\n' {
+					i++
+				}
+			}
+			if i < len(runes) && runes[i] == r {
+				i++
+			}
+		case unicode.IsDigit(r):
+			kind = TokenNumber
+			for i < len(runes) && (isIdentifierRune(runes[i]) || runes[i] == '.') {
+				i++
+			}
+		case isIdentifierRune(r):
+			for i < len(runes) && isIdentifierRune(runes[i]) {
+				i++
+			}
+			switch word := string(runes[start:i]); {
+			case self.isKeyword(word):
+				kind = TokenKeyword
+			case i < len(runes) && runes[i] == '(':
+				kind = TokenFunction
+			}
+		default:
+			for i++; i < len(runes) && !self.startsToken(runes, i); i++ {
+			}
+		}
+		tokens = append(tokens, Token{string(runes[start:i]), kind})
+	}
+	return tokens
+}
+
+func (self *SimpleLexer) isKeyword(word string) bool {
+	for _, keyword := range self.Keywords {
+		if word == keyword {
+			return true
+		}
+	}
+	return false
+}
+
+// startsToken reports whether a token other than text starts at runes[i].
+func (self *SimpleLexer) startsToken(runes []rune, i int) bool {
+	return isIdentifierRune(runes[i]) || strings.ContainsRune(self.StringDelimiters, runes[i]) ||
+		hasRunePrefix(runes[i:], self.LineComment) || hasRunePrefix(runes[i:], self.BlockCommentStart)
+}
+
+// hasRunePrefix reports whether runes begin with the non-empty prefix.
+func hasRunePrefix(runes []rune, prefix string) bool {
+	if prefix == "" {
+		return false
+	}
+	i := 0
+	for _, r := range prefix {
+		if i >= len(runes) || runes[i] != r {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+func isIdentifierRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
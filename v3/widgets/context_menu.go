@@ -0,0 +1,450 @@
+package widgets
+
+import (
+	"image"
+	"unicode"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// menuSubmenuArrow marks items that open a submenu.
+const menuSubmenuArrow = '▸'
+
+// MenuItem is an entry of a ContextMenu.
+type MenuItem struct {
+	Label string
+	// Enabled reports whether the item can currently be chosen. A nil Enabled means always enabled.
+	Enabled func() bool
+	// Action is called with the selection of the widget the menu was opened for.
+	Action func(selection interface{})
+
+	// Shortcut is the event ID of a key that chooses the item without opening the menu,
+	// e.g. "<C-s>". It is shown right aligned. MenuBar handles it.
+	Shortcut string
+	// Separator draws a line instead of the item, which can't be chosen.
+	Separator bool
+	// Items are shown in a submenu next to the item instead of calling the Action, see MenuBar.
+	Items []MenuItem
+}
+
+func (self MenuItem) enabled() bool {
+	return !self.Separator && (self.Enabled == nil || self.Enabled())
+}
+
+// ContextMenu is a popup list of MenuItems. It is normally managed by ContextMenus,
+// and has to be rendered after all other widgets so that it is drawn on top.
+type ContextMenu struct {
+	Block
+	Items            []MenuItem
+	SelectedRow      int
+	TextStyle        Style
+	SelectedRowStyle Style
+	DisabledStyle    Style
+
+	// Accelerators underlines the rune following a '&' in the labels, which chooses the
+	// item when it is typed, see parseAccelerator.
+	Accelerators bool
+}
+
+func NewContextMenu() *ContextMenu {
+	return &ContextMenu{
+		Block:            *NewBlock(),
+		TextStyle:        Theme.Menu.Text,
+		SelectedRowStyle: Theme.Menu.Selected,
+		DisabledStyle:    Theme.Menu.Disabled,
+	}
+}
+
+// label returns the label of an item without the accelerator marker and the index of
+// the accelerator, or -1.
+func (self *ContextMenu) label(item MenuItem) (string, int) {
+	if !self.Accelerators {
+		return item.Label, -1
+	}
+	return parseAccelerator(item.Label)
+}
+
+func (self *ContextMenu) Draw(buf *Buffer) {
+	if self.Empty() {
+		return
+	}
+	self.Block.Draw(buf)
+
+	for i, item := range self.Items {
+		y := self.Inner.Min.Y + i
+		if y >= self.Inner.Max.Y {
+			break
+		}
+		row := image.Rect(self.Inner.Min.X, y, self.Inner.Max.X, y+1)
+		if item.Separator {
+			buf.Fill(NewCell(HORIZONTAL_LINE, self.DisabledStyle), row)
+			continue
+		}
+		style := self.TextStyle
+		if !item.enabled() {
+			style = self.DisabledStyle
+		} else if i == self.SelectedRow {
+			style = self.SelectedRowStyle
+		}
+		buf.Fill(NewCell(' ', style), row)
+
+		maxX := self.Inner.Max.X - 1
+		if len(item.Items) > 0 {
+			buf.SetCell(NewCell(menuSubmenuArrow, style), image.Pt(maxX, y))
+			maxX--
+		} else if item.Shortcut != "" {
+			maxX -= rw.StringWidth(item.Shortcut)
+			buf.SetString(item.Shortcut, style, image.Pt(maxX, y))
+			maxX--
+		}
+		label, accelerator := self.label(item)
+		trimmed := TrimString(label, maxX-self.Inner.Min.X-1)
+		buf.SetString(trimmed, style, image.Pt(self.Inner.Min.X+1, y))
+		// the accelerator isn't underlined if it was cut off
+		if runes := []rune(trimmed); accelerator >= 0 && (trimmed == label || accelerator < len(runes)-1) {
+			style.Modifier |= ModifierUnderline
+			buf.SetCell(
+				NewCell(runes[accelerator], style),
+				image.Pt(self.Inner.Min.X+1+rw.StringWidth(string(runes[:accelerator])), y),
+			)
+		}
+	}
+}
+
+// OpenAt sizes the menu to fit its items and moves its top left corner to the given point.
+func (self *ContextMenu) OpenAt(p image.Point) {
+	width, suffix := 0, 0
+	for _, item := range self.Items {
+		label, _ := self.label(item)
+		width = MaxInt(width, rw.StringWidth(label))
+		if len(item.Items) > 0 {
+			suffix = MaxInt(suffix, 2)
+		} else if item.Shortcut != "" {
+			suffix = MaxInt(suffix, rw.StringWidth(item.Shortcut)+2)
+		}
+	}
+	self.SetRect(p.X, p.Y, p.X+width+suffix+4, p.Y+len(self.Items)+2)
+	self.SelectedRow = -1
+	self.ScrollDown()
+}
+
+// OpenWithin opens the menu at the given point like OpenAt, but moves it back into bounds
+// if it overflows them and bounds aren't empty.
+func (self *ContextMenu) OpenWithin(p image.Point, bounds image.Rectangle) {
+	self.OpenAt(p)
+	if !bounds.Empty() {
+		p = p.Sub(image.Pt(
+			MaxInt(self.Max.X-bounds.Max.X, 0),
+			MaxInt(self.Max.Y-bounds.Max.Y, 0),
+		))
+		self.OpenAt(p)
+	}
+}
+
+// AcceleratorRow returns the row of the first enabled item whose accelerator is key,
+// ignoring case, or -1 if there is none or Accelerators isn't set.
+func (self *ContextMenu) AcceleratorRow(key rune) int {
+	for i, item := range self.Items {
+		label, accelerator := self.label(item)
+		if accelerator >= 0 && item.enabled() && unicode.ToLower([]rune(label)[accelerator]) == unicode.ToLower(key) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Close hides the menu.
+func (self *ContextMenu) Close() {
+	self.SetRect(0, 0, 0, 0)
+}
+
+// IsOpen reports whether the menu is currently shown.
+func (self *ContextMenu) IsOpen() bool {
+	return !self.Empty()
+}
+
+// ScrollUp selects the previous enabled item.
+func (self *ContextMenu) ScrollUp() {
+	for i := self.SelectedRow - 1; i >= 0; i-- {
+		if self.Items[i].enabled() {
+			self.SelectedRow = i
+			return
+		}
+	}
+}
+
+// ScrollDown selects the next enabled item.
+func (self *ContextMenu) ScrollDown() {
+	for i := self.SelectedRow + 1; i < len(self.Items); i++ {
+		if self.Items[i].enabled() {
+			self.SelectedRow = i
+			return
+		}
+	}
+}
+
+// SelectedItem returns the selected item or nil if none of the items is enabled.
+func (self *ContextMenu) SelectedItem() *MenuItem {
+	if self.SelectedRow < 0 || self.SelectedRow >= len(self.Items) || !self.Items[self.SelectedRow].enabled() {
+		return nil
+	}
+	return &self.Items[self.SelectedRow]
+}
+
+type contextMenuEntry struct {
+	widget    Drawable
+	selection func() interface{}
+	items     []MenuItem
+}
+
+// SelectionLocator is implemented by widgets with a selected row, like List, Table and Tree.
+// ContextMenus opens the menu of OpenKey below it.
+type SelectionLocator interface {
+	// SelectionRect returns the area of the selected row as of the last Draw,
+	// which is empty if it isn't visible.
+	SelectionRect() image.Rectangle
+}
+
+// ContextMenus lets widgets register MenuItems that are shown in a ContextMenu
+// on a right-click on the widget, or on OpenKey for the Focused widget. Items with Items
+// open submenus next to the menu.
+//
+// Events have to be passed to HandleEvent and the Popups, Menu and its open submenus,
+// have to be rendered last:
+//
+//	for e := range ui.PollEvents() {
+//		if !menus.HandleEvent(e) {
+//			// handle other events
+//		}
+//		ui.Render(append([]ui.Drawable{grid}, menus.Popups()...)...)
+//	}
+type ContextMenus struct {
+	Menu *ContextMenu
+	// OpenKey is the event ID that opens the menu of the Focused widget, below its selected
+	// row if it is a SelectionLocator.
+	OpenKey string
+	// Focused is the widget whose menu is opened by OpenKey.
+	Focused Drawable
+	// Bounds is used to keep the menu on screen if it is not empty.
+	Bounds image.Rectangle
+
+	entries   []contextMenuEntry
+	selection interface{}
+	submenus  []*ContextMenu
+}
+
+func NewContextMenus() *ContextMenus {
+	menu := NewContextMenu()
+	menu.Close()
+	return &ContextMenus{
+		Menu:    menu,
+		OpenKey: "<F10>",
+	}
+}
+
+// Register adds menu items for a widget. selection is called when the menu is opened and
+// its result is passed to the Action of the chosen item. It may be nil.
+func (self *ContextMenus) Register(widget Drawable, selection func() interface{}, items ...MenuItem) {
+	for i, entry := range self.entries {
+		if entry.widget == widget {
+			self.entries[i].selection = selection
+			self.entries[i].items = append(entry.items, items...)
+			return
+		}
+	}
+	self.entries = append(self.entries, contextMenuEntry{widget, selection, items})
+}
+
+// Open opens the menu of the given widget at the given point.
+// It returns false if no items are registered for the widget.
+func (self *ContextMenus) Open(widget Drawable, p image.Point) bool {
+	for _, entry := range self.entries {
+		if entry.widget != widget || len(entry.items) == 0 {
+			continue
+		}
+		var selection interface{}
+		if entry.selection != nil {
+			selection = entry.selection()
+		}
+		self.OpenItems(entry.items, selection, p)
+		return true
+	}
+	return false
+}
+
+// OpenItems opens the menu with the given items at any point, e.g. the cursor of an editor,
+// without registering them. selection is passed to the Action of the chosen item.
+func (self *ContextMenus) OpenItems(items []MenuItem, selection interface{}, p image.Point) {
+	self.submenus = nil
+	self.selection = selection
+	self.Menu.Items = items
+	// move the menu back onto the screen if it overflows
+	self.Menu.OpenWithin(p, self.Bounds)
+}
+
+// Close closes the menu and its submenus.
+func (self *ContextMenus) Close() {
+	self.Menu.Close()
+	self.submenus = nil
+	self.selection = nil
+}
+
+// Popups returns the Menu followed by its open submenus, which have to be rendered
+// after all other widgets.
+func (self *ContextMenus) Popups() []Drawable {
+	popups := []Drawable{self.Menu}
+	for _, submenu := range self.submenus {
+		popups = append(popups, submenu)
+	}
+	return popups
+}
+
+// levels returns the Menu followed by its open submenus.
+func (self *ContextMenus) levels() []*ContextMenu {
+	return append([]*ContextMenu{self.Menu}, self.submenus...)
+}
+
+// deepest returns the open submenu that was opened last, or the Menu.
+func (self *ContextMenus) deepest() *ContextMenu {
+	if len(self.submenus) > 0 {
+		return self.submenus[len(self.submenus)-1]
+	}
+	return self.Menu
+}
+
+// closeSubmenu closes the deepest submenu, or the menu if no submenu is open.
+func (self *ContextMenus) closeSubmenu() {
+	if len(self.submenus) > 0 {
+		self.submenus = self.submenus[:len(self.submenus)-1]
+	} else {
+		self.Close()
+	}
+}
+
+// openSubmenu opens the submenu of the selected item of the deepest menu, if it has one.
+func (self *ContextMenus) openSubmenu() bool {
+	submenu := openSubmenuOf(self.deepest(), self.Bounds)
+	if submenu == nil {
+		return false
+	}
+	submenu.TextStyle, submenu.SelectedRowStyle, submenu.DisabledStyle = self.Menu.TextStyle, self.Menu.SelectedRowStyle, self.Menu.DisabledStyle
+	submenu.Accelerators = self.Menu.Accelerators
+	self.submenus = append(self.submenus, submenu)
+	return true
+}
+
+// activate opens the submenu of the selected item of the deepest menu, or calls its Action
+// and closes the menu.
+func (self *ContextMenus) activate() {
+	item := self.deepest().SelectedItem()
+	if item == nil || self.openSubmenu() {
+		return
+	}
+	selection := self.selection
+	self.Close()
+	if item.Action != nil {
+		item.Action(selection)
+	}
+}
+
+// HandleEvent opens, navigates and closes the menu. <Right> and <Enter> open the submenu of
+// the selected item, <Left> and <Escape> close the deepest submenu.
+// It returns true if the event was consumed by the menu.
+func (self *ContextMenus) HandleEvent(e Event) bool {
+	if self.Menu.IsOpen() {
+		switch e.ID {
+		case "<Up>", "k":
+			self.deepest().ScrollUp()
+		case "<Down>", "j":
+			self.deepest().ScrollDown()
+		case "<Right>", "l":
+			self.openSubmenu()
+		case "<Left>", "h":
+			if len(self.submenus) > 0 {
+				self.closeSubmenu()
+			}
+		case "<Enter>":
+			self.activate()
+		case "<Escape>":
+			self.closeSubmenu()
+		case "<MouseLeft>", "<MouseRight>":
+			mouse := e.Payload.(Mouse)
+			point := image.Pt(mouse.X, mouse.Y)
+			level := menuLevelAt(self.levels(), point)
+			if level < 0 {
+				self.Close()
+				if e.ID == "<MouseRight>" {
+					self.openAtMouse(point)
+				}
+				return true
+			}
+			self.submenus = self.submenus[:level]
+			self.deepest().SelectedRow = point.Y - self.deepest().Inner.Min.Y
+			self.activate()
+		case "<MouseRelease>":
+		default:
+			if e.Type == KeyboardEvent {
+				if r := []rune(e.ID); len(r) == 1 {
+					if row := self.deepest().AcceleratorRow(r[0]); row >= 0 {
+						self.deepest().SelectedRow = row
+						self.activate()
+					}
+				}
+				return true
+			}
+			return false
+		}
+		return true
+	}
+
+	switch {
+	case e.ID == "<MouseRight>":
+		mouse := e.Payload.(Mouse)
+		return self.openAtMouse(image.Pt(mouse.X, mouse.Y))
+	case e.ID == self.OpenKey && self.Focused != nil:
+		if locator, ok := self.Focused.(SelectionLocator); ok {
+			if rect := locator.SelectionRect(); !rect.Empty() {
+				return self.Open(self.Focused, image.Pt(rect.Min.X, rect.Max.Y))
+			}
+		}
+		return self.Open(self.Focused, self.Focused.GetRect().Min.Add(image.Pt(1, 1)))
+	}
+	return false
+}
+
+// openAtMouse opens the menu of the topmost registered widget under the given point.
+func (self *ContextMenus) openAtMouse(p image.Point) bool {
+	for i := len(self.entries) - 1; i >= 0; i-- {
+		if p.In(self.entries[i].widget.GetRect()) {
+			return self.Open(self.entries[i].widget, p)
+		}
+	}
+	return false
+}
+
+// openSubmenuOf returns a ContextMenu with the Items of the selected item of menu, opened
+// next to it within bounds, or nil if the item has no Items.
+func openSubmenuOf(menu *ContextMenu, bounds image.Rectangle) *ContextMenu {
+	item := menu.SelectedItem()
+	if item == nil || len(item.Items) == 0 {
+		return nil
+	}
+	submenu := NewContextMenu()
+	submenu.Items = item.Items
+	submenu.Accelerators = menu.Accelerators
+	p := image.Pt(menu.Max.X-1, menu.Inner.Min.Y+menu.SelectedRow-1)
+	submenu.OpenWithin(p, bounds)
+	return submenu
+}
+
+// menuLevelAt returns the index of the menu whose items contain p, checking the menus
+// opened last first since they are drawn on top, or -1.
+func menuLevelAt(menus []*ContextMenu, p image.Point) int {
+	for level := len(menus) - 1; level >= 0; level-- {
+		if p.In(menus[level].Inner) {
+			return level
+		}
+	}
+	return -1
+}
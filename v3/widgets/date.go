@@ -0,0 +1,19 @@
+package widgets
+
+import (
+	"time"
+)
+
+// DateFormat is the format of the dates used as map keys by Calendar.Highlights and
+// HeatStrip.Data.
+const DateFormat = "2006-01-02"
+
+// startOfDay returns the midnight starting the day of t.
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// daysIn returns the number of days of the month of t.
+func daysIn(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+}
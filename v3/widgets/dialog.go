@@ -0,0 +1,270 @@
+package widgets
+
+import (
+	"image"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+const (
+	dialogMinWidth      = 30
+	dialogButtonPadding = 4
+	dialogButtonSpacing = 2
+)
+
+// DialogResult is how a Dialog was closed.
+type DialogResult struct {
+	// Index is the index of the chosen button, or -1 if the Dialog was canceled.
+	Index  int
+	Button string
+	// Text is the text of the Input of a prompt.
+	Text     string
+	Canceled bool
+}
+
+// Confirmed reports whether the first button was chosen, e.g. "Yes" of a confirm dialog.
+func (self DialogResult) Confirmed() bool {
+	return self.Index == 0
+}
+
+// Dialog is a modal box with a Message, an optional text Input and a row of Buttons.
+// Like ContextMenus.Menu, it has to be rendered after all other widgets so that it is
+// drawn on top. While it is open, HandleEvent uses all events: <Tab>, <S-Tab> and the
+// arrow keys move the focus, <Enter> and clicks choose a button and <Escape> cancels.
+// The result is passed to OnClose and sent on the channel returned by Open.
+type Dialog struct {
+	Block
+	Message      string
+	MessageStyle Style
+	Input        *TextInput
+	Buttons      []*Button
+
+	OnClose func(result DialogResult)
+
+	// focused is the index of the focused button, or -1 for the Input.
+	focused int
+	done    chan DialogResult
+}
+
+// NewDialog returns a closed Dialog with a button for each label.
+func NewDialog(title, message string, buttons ...string) *Dialog {
+	self := &Dialog{
+		Block:        *NewBlock(),
+		Message:      message,
+		MessageStyle: Theme.Paragraph.Text,
+	}
+	self.Title = title
+	for i, label := range buttons {
+		i, button := i, NewButton(label)
+		button.Border = false
+		button.OnClick = func() { self.choose(i) }
+		self.Buttons = append(self.Buttons, button)
+	}
+	self.Close()
+	return self
+}
+
+// NewMessageDialog returns a Dialog that shows a message with an "OK" button.
+func NewMessageDialog(title, message string) *Dialog {
+	return NewDialog(title, message, "OK")
+}
+
+// NewConfirmDialog returns a Dialog asking a yes or no question, see DialogResult.Confirmed.
+func NewConfirmDialog(title, message string) *Dialog {
+	return NewDialog(title, message, "Yes", "No")
+}
+
+// NewPromptDialog returns a Dialog asking for text, which is returned in DialogResult.Text.
+func NewPromptDialog(title, message, placeholder string) *Dialog {
+	self := NewDialog(title, message, "OK", "Cancel")
+	self.Input = NewTextInput()
+	self.Input.Border = false
+	self.Input.Placeholder = placeholder
+	return self
+}
+
+// IsOpen reports whether the Dialog is shown.
+func (self *Dialog) IsOpen() bool {
+	return !self.Empty()
+}
+
+// messageLines returns the Message wrapped to width.
+func (self *Dialog) messageLines(width int) [][]Cell {
+	cells := WrapCells(ParseStyles(self.Message, self.MessageStyle), uint(MaxInt(width, 1)))
+	return SplitCells(cells, '\n')
+}
+
+// buttonsWidth returns the width of the row of Buttons.
+func (self *Dialog) buttonsWidth() int {
+	width := 0
+	for i, button := range self.Buttons {
+		if i > 0 {
+			width += dialogButtonSpacing
+		}
+		width += rw.StringWidth(button.Label) + dialogButtonPadding
+	}
+	return width
+}
+
+// Open shows the Dialog centered within bounds, e.g. the terminal, and focuses the Input
+// or the first button. The returned channel receives the result when the Dialog closes.
+func (self *Dialog) Open(bounds image.Rectangle) <-chan DialogResult {
+	message := CellsToString(ParseStyles(self.Message, self.MessageStyle))
+	width := MaxInt(rw.StringWidth(message), self.buttonsWidth())
+	width = MinInt(MaxInt(width, dialogMinWidth)+2, bounds.Dx())
+	height := len(self.messageLines(width-2)) + 4
+	if self.Input != nil {
+		self.Input.SetText("")
+		height += 2
+	}
+	height = MinInt(height, bounds.Dy())
+
+	min := bounds.Min.Add(image.Pt((bounds.Dx()-width)/2, (bounds.Dy()-height)/2))
+	self.SetRect(min.X, min.Y, min.X+width, min.Y+height)
+	self.focused = 0
+	if self.Input != nil {
+		self.focused = -1
+	}
+	self.done = make(chan DialogResult, 1)
+	return self.done
+}
+
+// Close hides the Dialog without a result.
+func (self *Dialog) Close() {
+	self.SetRect(0, 0, 0, 0)
+}
+
+// close hides the Dialog and reports the result.
+func (self *Dialog) close(result DialogResult) {
+	if !self.IsOpen() {
+		return
+	}
+	if self.Input != nil {
+		result.Text = self.Input.Text
+	}
+	self.Close()
+	if self.done != nil {
+		self.done <- result
+		self.done = nil
+	}
+	if self.OnClose != nil {
+		self.OnClose(result)
+	}
+}
+
+// choose closes the Dialog with the button at index.
+func (self *Dialog) choose(index int) {
+	if index >= 0 && index < len(self.Buttons) {
+		self.close(DialogResult{Index: index, Button: self.Buttons[index].Label})
+	}
+}
+
+// Cancel closes the Dialog with a canceled result.
+func (self *Dialog) Cancel() {
+	self.close(DialogResult{Index: -1, Canceled: true})
+}
+
+// FocusNext moves the focus to the next button or the Input, wrapping around.
+func (self *Dialog) FocusNext() {
+	self.focus(1)
+}
+
+// FocusPrevious moves the focus to the previous button or the Input, wrapping around.
+func (self *Dialog) FocusPrevious() {
+	self.focus(-1)
+}
+
+func (self *Dialog) focus(amount int) {
+	first := 0
+	if self.Input != nil {
+		first = -1
+	}
+	count := len(self.Buttons) - first
+	if count > 0 {
+		self.focused = (self.focused-first+amount+count)%count + first
+	}
+}
+
+func (self *Dialog) Draw(buf *Buffer) {
+	if !self.IsOpen() {
+		return
+	}
+	buf.Fill(NewCell(' ', self.MessageStyle), self.GetRect())
+	self.Block.Draw(buf)
+
+	y := self.Inner.Min.Y
+	for _, line := range self.messageLines(self.Inner.Dx()) {
+		if y >= self.Inner.Max.Y {
+			break
+		}
+		for _, cx := range BuildCellWithXArray(line) {
+			buf.SetCell(cx.Cell, image.Pt(self.Inner.Min.X+cx.X, y))
+		}
+		y++
+	}
+
+	if self.Input != nil {
+		y = MinInt(y+1, self.Inner.Max.Y-2)
+		self.Input.HideCursor = self.focused != -1
+		self.Input.SetRect(self.Inner.Min.X, y, self.Inner.Max.X, y+1)
+		self.Input.Draw(buf)
+	}
+
+	x := self.Inner.Min.X + MaxInt(self.Inner.Dx()-self.buttonsWidth(), 0)/2
+	y = self.Inner.Max.Y - 1
+	for i, button := range self.Buttons {
+		width := rw.StringWidth(button.Label) + dialogButtonPadding
+		button.Focused = i == self.focused
+		button.SetRect(x, y, MinInt(x+width, self.Inner.Max.X), y+1)
+		button.Draw(buf)
+		x += width + dialogButtonSpacing
+	}
+}
+
+// HandleEvent handles all events while the Dialog is open and returns whether it is open.
+// Events are passed to the focused button or Input, except for the ones moving the focus
+// and <Escape>.
+func (self *Dialog) HandleEvent(e Event) bool {
+	if !self.IsOpen() {
+		return false
+	}
+	switch e.Type {
+	case KeyboardEvent:
+		switch e.ID {
+		case "<Tab>":
+			self.FocusNext()
+		case "<S-Tab>":
+			self.FocusPrevious()
+		case "<Escape>":
+			self.Cancel()
+		case "<Left>", "<Right>":
+			if self.focused < 0 {
+				self.Input.HandleEvent(e)
+			} else if e.ID == "<Left>" {
+				self.focused = MaxInt(self.focused-1, 0)
+			} else {
+				self.focused = MinInt(self.focused+1, len(self.Buttons)-1)
+			}
+		case "<Enter>":
+			// submitting the Input chooses the first button
+			self.choose(MaxInt(self.focused, 0))
+		default:
+			if self.focused < 0 {
+				self.Input.HandleEvent(e)
+			} else if self.focused < len(self.Buttons) {
+				self.Buttons[self.focused].HandleEvent(e)
+			}
+		}
+	case MouseEvent:
+		if self.Input != nil && self.Input.HandleEvent(e) {
+			self.focused = -1
+		}
+		for i, button := range self.Buttons {
+			if button.HandleEvent(e) && e.ID == "<MouseLeft>" {
+				self.focused = i
+			}
+		}
+	}
+	return true
+}
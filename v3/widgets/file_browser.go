@@ -0,0 +1,282 @@
+package widgets
+
+import (
+	"image"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// FileSort is the order of the entries of a FileBrowser. Directories always come first.
+type FileSort uint
+
+const (
+	FileSortName FileSort = iota
+	FileSortSize
+	FileSortModTime
+)
+
+// fileBrowserParent is the entry that leads to the parent directory.
+const fileBrowserParent = ".."
+
+// FileBrowser is a List of the entries of the directory Dir. Entering a directory changes
+// Dir, choosing a file calls OnSelect, see HandleEvent.
+type FileBrowser struct {
+	List
+	Dir string
+
+	// ShowHidden shows the entries starting with a dot.
+	ShowHidden bool
+	// Pattern hides files whose name doesn't match it, see filepath.Match. Directories
+	// are always shown.
+	Pattern        string
+	Sort           FileSort
+	SortDescending bool
+
+	DirectoryStyle Style
+
+	// OnSelect is called with the path of a chosen file, OnChangeDir with the new Dir.
+	OnSelect    func(path string)
+	OnChangeDir func(dir string)
+
+	// Err is the error of the last read of the Dir, see Refresh.
+	Err error
+
+	entries []os.FileInfo
+}
+
+// NewFileBrowser returns a FileBrowser showing dir, or the working directory if dir is "".
+func NewFileBrowser(dir string) *FileBrowser {
+	self := &FileBrowser{
+		List:           *NewList(),
+		DirectoryStyle: Theme.FileBrowser.Directory,
+	}
+	if dir == "" {
+		dir, _ = os.Getwd()
+	}
+	self.SetDir(dir)
+	return self
+}
+
+// SetDir shows the entries of dir and selects the first one.
+func (self *FileBrowser) SetDir(dir string) error {
+	if absolute, err := filepath.Abs(dir); err == nil {
+		dir = absolute
+	}
+	self.Dir = dir
+	self.SelectedRow = 0
+	self.entries = nil
+	err := self.Refresh()
+	if self.OnChangeDir != nil {
+		self.OnChangeDir(dir)
+	}
+	return err
+}
+
+// hasParent reports whether the Dir has a parent directory, which is the first entry.
+func (self *FileBrowser) hasParent() bool {
+	return filepath.Dir(self.Dir) != self.Dir
+}
+
+// Refresh reads the Dir again and applies ShowHidden, Pattern and Sort, keeping the
+// selected entry selected if it still exists.
+func (self *FileBrowser) Refresh() error {
+	selected := self.SelectedPath()
+	infos, err := ioutil.ReadDir(self.Dir)
+	self.Err = err
+
+	self.entries = self.entries[:0]
+	for _, info := range infos {
+		name := info.Name()
+		if !self.ShowHidden && strings.HasPrefix(name, ".") {
+			continue
+		}
+		if !info.IsDir() && self.Pattern != "" {
+			if ok, _ := filepath.Match(self.Pattern, name); !ok {
+				continue
+			}
+		}
+		self.entries = append(self.entries, info)
+	}
+	sort.SliceStable(self.entries, func(i, j int) bool {
+		a, b := self.entries[i], self.entries[j]
+		if a.IsDir() != b.IsDir() {
+			return a.IsDir()
+		}
+		if self.SortDescending {
+			a, b = b, a
+		}
+		switch self.Sort {
+		case FileSortSize:
+			if a.Size() != b.Size() {
+				return a.Size() < b.Size()
+			}
+		case FileSortModTime:
+			if !a.ModTime().Equal(b.ModTime()) {
+				return a.ModTime().Before(b.ModTime())
+			}
+		}
+		return strings.ToLower(a.Name()) < strings.ToLower(b.Name())
+	})
+
+	self.Items = make([]ListItem, 0, len(self.entries)+1)
+	if self.hasParent() {
+		self.Items = append(self.Items, ListItem{Title: fileBrowserParent + "/", Style: &self.DirectoryStyle})
+	}
+	for _, info := range self.entries {
+		item := ListItem{Title: info.Name()}
+		if info.IsDir() {
+			item.Title += "/"
+			item.Style = &self.DirectoryStyle
+		} else {
+			item.Detail = FormatBytes(float64(info.Size()))
+		}
+		self.Items = append(self.Items, item)
+	}
+	self.SelectPath(selected)
+	return err
+}
+
+// entry returns the name of the entry in row and whether it is a directory.
+func (self *FileBrowser) entry(row int) (string, bool) {
+	if self.hasParent() {
+		if row == 0 {
+			return fileBrowserParent, true
+		}
+		row--
+	}
+	if row < 0 || row >= len(self.entries) {
+		return "", false
+	}
+	return self.entries[row].Name(), self.entries[row].IsDir()
+}
+
+// SelectedPath returns the path of the selected entry, or "" if there is none.
+func (self *FileBrowser) SelectedPath() string {
+	name, _ := self.entry(self.SelectedRow)
+	if name == "" || name == fileBrowserParent {
+		return ""
+	}
+	return filepath.Join(self.Dir, name)
+}
+
+// SelectPath selects the entry with the given path and returns whether it is shown.
+func (self *FileBrowser) SelectPath(path string) bool {
+	if path == "" || filepath.Dir(path) != self.Dir {
+		self.SelectedRow = MinInt(self.SelectedRow, MaxInt(len(self.Items)-1, 0))
+		return false
+	}
+	for row := range self.Items {
+		if name, _ := self.entry(row); name == filepath.Base(path) {
+			self.SelectedRow = row
+			return true
+		}
+	}
+	self.SelectedRow = MinInt(self.SelectedRow, MaxInt(len(self.Items)-1, 0))
+	return false
+}
+
+// Open enters the selected directory, or calls OnSelect with the path of the selected file.
+func (self *FileBrowser) Open() {
+	name, dir := self.entry(self.SelectedRow)
+	switch {
+	case name == fileBrowserParent:
+		self.Up()
+	case dir:
+		self.SetDir(filepath.Join(self.Dir, name))
+	case name != "" && self.OnSelect != nil:
+		self.OnSelect(filepath.Join(self.Dir, name))
+	}
+}
+
+// Up shows the parent directory with the directory that was shown selected.
+func (self *FileBrowser) Up() {
+	if !self.hasParent() {
+		return
+	}
+	previous := self.Dir
+	self.SetDir(filepath.Dir(previous))
+	self.SelectPath(previous)
+}
+
+// ToggleHidden shows or hides the entries starting with a dot.
+func (self *FileBrowser) ToggleHidden() {
+	self.ShowHidden = !self.ShowHidden
+	self.Refresh()
+}
+
+// SetPattern hides files whose name doesn't match pattern, see Pattern.
+func (self *FileBrowser) SetPattern(pattern string) {
+	self.Pattern = pattern
+	self.Refresh()
+}
+
+// SetSort sorts the entries by sort, reversing the order if it already is sort.
+func (self *FileBrowser) SetSort(sort FileSort) {
+	if self.Sort == sort {
+		self.SortDescending = !self.SortDescending
+	} else {
+		self.Sort, self.SortDescending = sort, false
+	}
+	self.Refresh()
+}
+
+// CycleSort sorts the entries by the next FileSort.
+func (self *FileBrowser) CycleSort() {
+	self.SetSort((self.Sort + 1) % (FileSortModTime + 1))
+}
+
+// HandleEvent moves the selection with <Up>, <Down>, <PageUp>, <PageDown>, <Home>, <End>
+// and the mouse wheel, opens the selected entry with <Enter>, <Right> and clicks on the
+// selected entry, goes up with <Backspace> and <Left>, toggles hidden entries with "."
+// and cycles the sort with "s". It returns whether the event was used.
+func (self *FileBrowser) HandleEvent(e Event) bool {
+	switch e.ID {
+	case "<Up>", "k", "<MouseWheelUp>":
+		self.ScrollUp()
+	case "<Down>", "j", "<MouseWheelDown>":
+		self.ScrollDown()
+	case "<PageUp>":
+		self.ScrollPageUp()
+	case "<PageDown>":
+		self.ScrollPageDown()
+	case "<Home>", "g":
+		self.ScrollTop()
+	case "<End>", "G":
+		self.ScrollBottom()
+	case "<Enter>", "<Right>", "l":
+		self.Open()
+	case "<Backspace>", "<C-<Backspace>>", "<Left>", "h":
+		self.Up()
+	case ".":
+		self.ToggleHidden()
+	case "s":
+		self.CycleSort()
+	case "<MouseLeft>":
+		mouse, ok := e.Payload.(Mouse)
+		if !ok || mouse.Drag {
+			return false
+		}
+		row := self.rowAt(image.Pt(mouse.X, mouse.Y))
+		if row < 0 {
+			return false
+		}
+		if row == self.SelectedRow {
+			self.Open()
+		} else {
+			self.SelectedRow = row
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// FormValue returns the SelectedPath, see Form.
+func (self *FileBrowser) FormValue() interface{} {
+	return self.SelectedPath()
+}
@@ -0,0 +1,248 @@
+package widgets
+
+import (
+	"image"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// FormInput is a widget that edits the value of a FormField, like TextInput, Checkbox and Select.
+type FormInput interface {
+	Drawable
+	HandleEvent(Event) bool
+	FormValue() interface{}
+}
+
+// FormField is a labeled input of a Form.
+type FormField struct {
+	// Name is the key of the value in Form.Values.
+	Name  string
+	Label string
+	Input FormInput
+	// Validator checks the value of the Input when the field loses the focus and when the
+	// Form is submitted. The error is shown below the field.
+	Validator func(value interface{}) error
+
+	err error
+}
+
+// Error returns the error of the last validation of the field, or nil.
+func (self *FormField) Error() error {
+	return self.err
+}
+
+// validate runs the Validator and keeps its error.
+func (self *FormField) validate() error {
+	self.err = nil
+	if self.Validator != nil {
+		self.err = self.Validator(self.Input.FormValue())
+	}
+	return self.err
+}
+
+// Form lays out labeled fields in rows and moves the focus between them with NextKey and
+// PreviousKey. The focused field receives the events first, Enter submits the Form if the
+// field doesn't use it, see HandleEvent.
+type Form struct {
+	Block
+	Fields []*FormField
+
+	LabelStyle        Style
+	FocusedLabelStyle Style
+	ErrorStyle        Style
+
+	NextKey     string
+	PreviousKey string
+
+	// OnSubmit is called with the Values when the Form is submitted and all fields are valid.
+	OnSubmit func(values map[string]interface{})
+
+	focused int
+}
+
+func NewForm() *Form {
+	return &Form{
+		Block:             *NewBlock(),
+		LabelStyle:        Theme.Form.Label,
+		FocusedLabelStyle: Theme.Form.FocusedLabel,
+		ErrorStyle:        Theme.Form.Error,
+		NextKey:           "<Tab>",
+		PreviousKey:       "<S-Tab>",
+	}
+}
+
+// AddField appends a field and returns it. The built-in inputs lose their borders,
+// since every field takes up a single row.
+func (self *Form) AddField(name, label string, input FormInput, validator func(value interface{}) error) *FormField {
+	switch input := input.(type) {
+	case *TextInput:
+		input.Border = false
+	case *Checkbox:
+		input.Border = false
+	case *Select:
+		input.Border = false
+	case *Slider:
+		input.Border = false
+	case *RadioGroup:
+		input.Border = false
+		input.Horizontal = true
+	}
+	field := &FormField{Name: name, Label: label, Input: input, Validator: validator}
+	self.Fields = append(self.Fields, field)
+	return field
+}
+
+// Focused returns the focused field, or nil if there are no fields.
+func (self *Form) Focused() *FormField {
+	if self.focused < 0 || self.focused >= len(self.Fields) {
+		return nil
+	}
+	return self.Fields[self.focused]
+}
+
+// FocusField moves the focus to the field at index, validating the field that loses it.
+func (self *Form) FocusField(index int) {
+	if index < 0 || index >= len(self.Fields) || index == self.focused {
+		return
+	}
+	if field := self.Focused(); field != nil {
+		field.validate()
+	}
+	self.focused = index
+}
+
+// FocusNext moves the focus to the next field, wrapping around.
+func (self *Form) FocusNext() {
+	if len(self.Fields) > 0 {
+		self.FocusField((self.focused + 1) % len(self.Fields))
+	}
+}
+
+// FocusPrevious moves the focus to the previous field, wrapping around.
+func (self *Form) FocusPrevious() {
+	if len(self.Fields) > 0 {
+		self.FocusField((self.focused - 1 + len(self.Fields)) % len(self.Fields))
+	}
+}
+
+// Values returns the values of the inputs by field name.
+func (self *Form) Values() map[string]interface{} {
+	values := make(map[string]interface{}, len(self.Fields))
+	for _, field := range self.Fields {
+		values[field.Name] = field.Input.FormValue()
+	}
+	return values
+}
+
+// ValidateFields validates all fields and returns whether they are valid.
+// The focus moves to the first invalid field.
+func (self *Form) ValidateFields() bool {
+	invalid := -1
+	for i, field := range self.Fields {
+		if field.validate() != nil && invalid < 0 {
+			invalid = i
+		}
+	}
+	if invalid >= 0 {
+		self.focused = invalid
+	}
+	return invalid < 0
+}
+
+// Submit validates the fields and, if they are valid, calls OnSubmit with the Values and
+// returns them. It returns nil and false if a field is invalid.
+func (self *Form) Submit() (map[string]interface{}, bool) {
+	if !self.ValidateFields() {
+		return nil, false
+	}
+	values := self.Values()
+	if self.OnSubmit != nil {
+		self.OnSubmit(values)
+	}
+	return values, true
+}
+
+// labelWidth returns the width of the widest label.
+func (self *Form) labelWidth() int {
+	width := 0
+	for _, field := range self.Fields {
+		width = MaxInt(width, rw.StringWidth(field.Label))
+	}
+	return width
+}
+
+func (self *Form) Draw(buf *Buffer) {
+	self.Block.Draw(buf)
+
+	labelWidth := MinInt(self.labelWidth(), self.Inner.Dx()/2)
+	inputX := self.Inner.Min.X + labelWidth + 2
+	y := self.Inner.Min.Y
+	for i, field := range self.Fields {
+		style := self.LabelStyle
+		if i == self.focused {
+			style = self.FocusedLabelStyle
+		}
+		if y >= self.Inner.Max.Y {
+			// fields that don't fit are moved out of the way, so that clicks don't reach them
+			field.Input.SetRect(0, 0, 0, 0)
+			continue
+		}
+		buf.SetString(TrimString(field.Label, labelWidth), style, image.Pt(self.Inner.Min.X, y))
+
+		if input, ok := field.Input.(*TextInput); ok {
+			input.HideCursor = i != self.focused
+		}
+		field.Input.SetRect(inputX, y, self.Inner.Max.X, y+1)
+		field.Input.Lock()
+		field.Input.Draw(buf)
+		field.Input.Unlock()
+		y++
+
+		if field.err != nil && y < self.Inner.Max.Y {
+			buf.SetString(TrimString(field.err.Error(), self.Inner.Max.X-inputX), self.ErrorStyle, image.Pt(inputX, y))
+			y++
+		}
+	}
+}
+
+// HandleEvent focuses clicked fields and passes the events to the focused input first.
+// Events it doesn't use move the focus with NextKey and PreviousKey, or submit the Form
+// with <Enter>. It returns whether the event was used.
+func (self *Form) HandleEvent(e Event) bool {
+	if mouse, ok := e.Payload.(Mouse); ok && e.Type == MouseEvent && e.ID == "<MouseLeft>" && !mouse.Drag {
+		for i, field := range self.Fields {
+			if image.Pt(mouse.X, mouse.Y).In(field.Input.GetRect()) {
+				self.FocusField(i)
+			}
+		}
+	}
+	if field := self.Focused(); field != nil && field.Input.HandleEvent(e) {
+		return true
+	}
+	if e.Type != KeyboardEvent {
+		return false
+	}
+	switch e.ID {
+	case "":
+		return false
+	case self.NextKey:
+		self.FocusNext()
+	case self.PreviousKey:
+		self.FocusPrevious()
+	case "<Enter>":
+		self.Submit()
+	default:
+		return false
+	}
+	return true
+}
+
+// Children returns the inputs of the fields.
+func (self *Form) Children() []Drawable {
+	children := make([]Drawable, len(self.Fields))
+	for i, field := range self.Fields {
+		children[i] = field.Input
+	}
+	return children
+}
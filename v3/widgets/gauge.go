@@ -7,6 +7,7 @@ package widgets
 import (
 	"fmt"
 	"image"
+	"time"
 
 	. "github.com/s-westphal/termui/v3"
 )
@@ -17,29 +18,85 @@ type Gauge struct {
 	BarColor   Color
 	Label      string
 	LabelStyle Style
+
+	// Thresholds color the bar with the color of the highest threshold whose Value the
+	// Percent reaches instead of BarColor, e.g. green from 0, yellow from 70 and red from 90.
+	Thresholds []Threshold
+
+	// Indeterminate replaces the bar with a segment moving back and forth, e.g. for tasks
+	// whose progress is unknown. The Label is shown without a percentage.
+	// The segment moves by one cell every FrameDuration according to DefaultClock, so that
+	// drawing doesn't move it. Frame is added to the position, apps that animate with a tick
+	// counter can set it before drawing and set FrameDuration to 0 instead.
+	Indeterminate bool
+	FrameDuration time.Duration
+	Frame         int
+
+	// Segments replace the bar with several stacked bars if set, e.g. for used, cached
+	// and free memory. Percent, Label and the colors of the bar are ignored then.
+	Segments []GaugeSegment
+}
+
+// barColor returns the color of the bar according to the Thresholds.
+func (self *Gauge) barColor() Color {
+	return ThresholdColor(self.Thresholds, float64(self.Percent), self.BarColor)
 }
 
 func NewGauge() *Gauge {
 	return &Gauge{
-		Block:      *NewBlock(),
-		BarColor:   Theme.Gauge.Bar,
-		LabelStyle: Theme.Gauge.Label,
+		Block:         *NewBlock(),
+		BarColor:      Theme.Gauge.Bar,
+		LabelStyle:    Theme.Gauge.Label,
+		FrameDuration: 100 * time.Millisecond,
 	}
 }
 
+// segment returns the start and width of the moving segment in Indeterminate mode,
+// which is a quarter of the gauge wide and bounces between its edges.
+func (self *Gauge) segment() (int, int) {
+	width := MaxInt(self.Inner.Dx()/4, 1)
+	span := MaxInt(self.Inner.Dx()-width, 0)
+	if span == 0 {
+		return self.Inner.Min.X, width
+	}
+	position := int64(self.Frame)
+	if self.FrameDuration > 0 {
+		position += DefaultClock.Now().UnixNano() / int64(self.FrameDuration)
+	}
+	offset := int(position % int64(2*span))
+	if offset < 0 {
+		offset += 2 * span
+	}
+	if offset > span {
+		offset = 2*span - offset
+	}
+	return self.Inner.Min.X + offset, width
+}
+
 func (self *Gauge) Draw(buf *Buffer) {
 	self.Block.Draw(buf)
 
+	if len(self.Segments) > 0 {
+		self.drawSegments(buf)
+		return
+	}
+
 	label := self.Label
-	if label == "" {
+	if label == "" && !self.Indeterminate {
 		label = fmt.Sprintf("%d%%", self.Percent)
 	}
 
+	barColor := self.barColor()
+
 	// plot bar
+	barStart := self.Inner.Min.X
 	barWidth := int((float64(self.Percent) / 100) * float64(self.Inner.Dx()))
+	if self.Indeterminate {
+		barStart, barWidth = self.segment()
+	}
 	buf.Fill(
-		NewCell(' ', NewStyle(ColorClear, self.BarColor)),
-		image.Rect(self.Inner.Min.X, self.Inner.Min.Y, self.Inner.Min.X+barWidth, self.Inner.Max.Y),
+		NewCell(' ', NewStyle(ColorClear, barColor)),
+		image.Rect(barStart, self.Inner.Min.Y, barStart+barWidth, self.Inner.Max.Y),
 	)
 
 	// plot label
@@ -48,8 +105,8 @@ func (self *Gauge) Draw(buf *Buffer) {
 	if labelYCoordinate < self.Inner.Max.Y {
 		for i, char := range label {
 			style := self.LabelStyle
-			if labelXCoordinate+i+1 <= self.Inner.Min.X+barWidth {
-				style = NewStyle(self.BarColor, ColorClear, ModifierReverse)
+			if x := labelXCoordinate + i; x >= barStart && x < barStart+barWidth {
+				style = NewStyle(barColor, ColorClear, ModifierReverse)
 			}
 			buf.SetCell(NewCell(char, style), image.Pt(labelXCoordinate+i, labelYCoordinate))
 		}
@@ -0,0 +1,51 @@
+package widgets
+
+import (
+	"fmt"
+	"image"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// GaugeSegment is a part of a Gauge with Segments, e.g. the cached memory.
+// Its Label, followed by its percentage if ShowPercent is set, is drawn inside
+// the segment if it fits.
+type GaugeSegment struct {
+	Percent     int
+	Color       Color
+	Label       string
+	ShowPercent bool
+}
+
+// drawSegments draws the Segments of the gauge next to each other, from left to right.
+func (self *Gauge) drawSegments(buf *Buffer) {
+	x := self.Inner.Min.X
+	total := 0
+	for _, segment := range self.Segments {
+		// the end of a segment is derived from the running total, so that rounding
+		// errors don't add up
+		total += segment.Percent
+		end := self.Inner.Min.X + int(float64(MinInt(total, 100))/100*float64(self.Inner.Dx()))
+		if end <= x {
+			continue
+		}
+		buf.Fill(
+			NewCell(' ', NewStyle(ColorClear, segment.Color)),
+			image.Rect(x, self.Inner.Min.Y, end, self.Inner.Max.Y),
+		)
+
+		label := segment.Label
+		if segment.ShowPercent {
+			label = fmt.Sprintf("%s %d%%", label, segment.Percent)
+		}
+		if width := rw.StringWidth(label); width > 0 && width <= end-x {
+			buf.SetString(
+				label,
+				NewStyle(segment.Color, ColorClear, ModifierReverse),
+				image.Pt(x+(end-x-width)/2, self.Inner.Min.Y+(self.Inner.Dy()-1)/2),
+			)
+		}
+		x = end
+	}
+}
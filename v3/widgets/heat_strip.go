@@ -0,0 +1,197 @@
+package widgets
+
+import (
+	"fmt"
+	"image"
+	"time"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+const heatStripLabelWidth = 4
+
+// HeatStripLevel colors the days with a value of at least Min.
+type HeatStripLevel struct {
+	Min   float64
+	Color Color
+	Label string
+}
+
+// HeatStrip shows the status of every day of the last Months months as one colored cell,
+// with one row per month, e.g. for uptime or SLA dashboards. The color of a day is the one
+// of the highest Level whose Min it reaches, days without data use MissingColor.
+type HeatStrip struct {
+	Block
+	// Data maps days, formatted with DateFormat, to their values.
+	Data         map[string]float64
+	Levels       []HeatStripLevel
+	MissingColor Color
+	TextStyle    Style
+
+	// End is the last day shown and defaults to today according to DefaultClock.
+	End    time.Time
+	Months int
+
+	// ShowLegend adds a line with the color of every Level below the days. Its entries are
+	// "legend" hit regions with the index of the Level, or -1 for the MissingColor.
+	ShowLegend bool
+
+	// Hovered is the day described in the readout line below the days, see Hover.
+	// The readout is empty if it is zero. ValueFormatter formats the value of the day.
+	Hovered        time.Time
+	ValueFormatter func(float64) string
+}
+
+// NewHeatStrip returns a HeatStrip of the last 3 months, with levels for uptime percentages.
+func NewHeatStrip() *HeatStrip {
+	return &HeatStrip{
+		Block: *NewBlock(),
+		Data:  make(map[string]float64),
+		Levels: []HeatStripLevel{
+			{Min: 0, Color: Theme.HeatStrip.Levels[0], Label: "<99%"},
+			{Min: 99, Color: Theme.HeatStrip.Levels[1], Label: "≥99%"},
+			{Min: 99.9, Color: Theme.HeatStrip.Levels[2], Label: "≥99.9%"},
+		},
+		MissingColor:   Theme.HeatStrip.Missing,
+		TextStyle:      Theme.HeatStrip.Text,
+		Months:         3,
+		ShowLegend:     true,
+		ValueFormatter: func(v float64) string { return fmt.Sprintf("%.2f%%", v) },
+	}
+}
+
+// SetDay sets the value of the day of t.
+func (self *HeatStrip) SetDay(t time.Time, value float64) {
+	self.Data[t.Format(DateFormat)] = value
+}
+
+// Day returns the value of the day of t and whether there is one.
+func (self *HeatStrip) Day(t time.Time) (float64, bool) {
+	value, ok := self.Data[t.Format(DateFormat)]
+	return value, ok
+}
+
+func (self *HeatStrip) end() time.Time {
+	end := self.End
+	if end.IsZero() {
+		end = DefaultClock.Now()
+	}
+	return startOfDay(end)
+}
+
+// months returns the first day of the months that fit into the widget, oldest first.
+func (self *HeatStrip) months() []time.Time {
+	// the readout line is reserved even if there is no hovered day, so that hovering
+	// doesn't move the days
+	rows := self.Inner.Dy() - 1
+	if self.ShowLegend {
+		rows--
+	}
+	end := self.end()
+	first := time.Date(end.Year(), end.Month(), 1, 0, 0, 0, 0, end.Location())
+	months := []time.Time{}
+	for i := MinInt(self.Months, rows) - 1; i >= 0; i-- {
+		months = append(months, first.AddDate(0, -i, 0))
+	}
+	return months
+}
+
+// dayPoint returns the cell of day d of the month in row i.
+func (self *HeatStrip) dayPoint(i, d int) image.Point {
+	return image.Pt(self.Inner.Min.X+heatStripLabelWidth+d-1, self.Inner.Min.Y+i)
+}
+
+// cell returns the cell of a day. Days without data are shaded lightly.
+func (self *HeatStrip) cell(day time.Time) Cell {
+	value, ok := self.Day(day)
+	if !ok {
+		return NewCell(SHADED_BLOCKS[1], NewStyle(self.MissingColor))
+	}
+	color := self.MissingColor
+	for _, level := range self.Levels {
+		if value >= level.Min {
+			color = level.Color
+		}
+	}
+	return NewCell(SHADED_BLOCKS[4], NewStyle(color))
+}
+
+// Hover sets Hovered to the day at p, e.g. the position of a mouse event,
+// and reports whether there is a day at p.
+func (self *HeatStrip) Hover(p image.Point) bool {
+	end := self.end()
+	for i, month := range self.months() {
+		d := p.X - self.dayPoint(i, 1).X + 1
+		day := month.AddDate(0, 0, d-1)
+		if p.Y == self.dayPoint(i, 1).Y && d >= 1 && day.Month() == month.Month() && !day.After(end) {
+			self.Hovered = day
+			return true
+		}
+	}
+	return false
+}
+
+// drawLegendLabel draws a label of the legend and returns the column of the next entry.
+func (self *HeatStrip) drawLegendLabel(buf *Buffer, label string, x, y int) int {
+	buf.SetString(TrimString(label, MaxInt(self.Inner.Max.X-x, 0)), self.TextStyle, image.Pt(x, y))
+	return x + rw.StringWidth(label) + 2
+}
+
+func (self *HeatStrip) Draw(buf *Buffer) {
+	self.Block.Draw(buf)
+
+	end := self.end()
+	months := self.months()
+	for i, month := range months {
+		buf.SetString(month.Month().String()[:3], self.TextStyle, image.Pt(self.Inner.Min.X, self.Inner.Min.Y+i))
+		for day := month; day.Month() == month.Month() && !day.After(end); day = day.AddDate(0, 0, 1) {
+			p := self.dayPoint(i, day.Day())
+			if p.X >= self.Inner.Max.X {
+				break
+			}
+			buf.SetCell(self.cell(day), p)
+			buf.AddHitRegion(self, "day", image.Rectangle{p, p.Add(image.Pt(1, 1))}, day)
+		}
+	}
+
+	y := self.Inner.Min.Y + len(months)
+	if self.ShowLegend && y < self.Inner.Max.Y {
+		x := self.Inner.Min.X + heatStripLabelWidth
+		entries := []Cell{}
+		labels := []string{}
+		for _, level := range self.Levels {
+			entries = append(entries, NewCell(SHADED_BLOCKS[4], NewStyle(level.Color)))
+			labels = append(labels, level.Label)
+		}
+		entries = append(entries, NewCell(SHADED_BLOCKS[1], NewStyle(self.MissingColor)))
+		labels = append(labels, "no data")
+		for i, cell := range entries {
+			if x >= self.Inner.Max.X {
+				break
+			}
+			buf.SetCell(cell, image.Pt(x, y))
+			next := self.drawLegendLabel(buf, labels[i], x+2, y)
+			// the entry of the MissingColor has the index -1
+			level := i
+			if i == len(self.Levels) {
+				level = -1
+			}
+			buf.AddHitRegion(self, "legend", image.Rect(x, y, next-2, y+1), level)
+			x = next
+		}
+		y++
+	}
+
+	if !self.Hovered.IsZero() && y < self.Inner.Max.Y {
+		readout := "no data"
+		if value, ok := self.Day(self.Hovered); ok {
+			readout = self.ValueFormatter(value)
+		}
+		buf.SetString(
+			TrimString(self.Hovered.Format("Mon "+DateFormat)+": "+readout, self.Inner.Dx()),
+			self.TextStyle,
+			image.Pt(self.Inner.Min.X, y),
+		)
+	}
+}
@@ -7,6 +7,7 @@ package widgets
 import (
 	"image"
 	"image/color"
+	"time"
 
 	. "github.com/s-westphal/termui/v3"
 )
@@ -17,6 +18,32 @@ type Image struct {
 	Monochrome          bool
 	MonochromeThreshold uint8
 	MonochromeInvert    bool
+
+	// Renderer is how the Image is drawn with cells. ImageBraille uses the
+	// MonochromeThreshold and MonochromeInvert as well.
+	Renderer ImageRenderer
+
+	// Frames replace the Image if set and are shown one after another, each for its
+	// entry in Delays, see NewAnimatedImage. The animation advances when the Image is drawn
+	// unless it is Paused, or with NextFrame. Frame is the index of the current frame.
+	// LoopCount is how often the animation is played, 0 loops forever.
+	Frames    []image.Image
+	Delays    []time.Duration
+	Frame     int
+	LoopCount int
+	Paused    bool
+
+	loops      int
+	frameStart time.Time
+
+	// Scaling is how the Image is scaled into the Inner area and Sampling how the colors
+	// of the scaled pixels are computed.
+	Scaling  ImageScaling
+	Sampling ImageSampling
+
+	// PixelGraphics draws the Image as a bitmap over the cells if the terminal supports it,
+	// see Graphics. The cells are drawn as usual and remain as fallback.
+	PixelGraphics bool
 }
 
 func NewImage(img image.Image) *Image {
@@ -24,54 +51,48 @@ func NewImage(img image.Image) *Image {
 		Block:               *NewBlock(),
 		MonochromeThreshold: 128,
 		Image:               img,
+		Scaling:             ImageFit,
+		PixelGraphics:       true,
 	}
 }
 
 func (self *Image) Draw(buf *Buffer) {
 	self.Block.Draw(buf)
 
+	if len(self.Frames) > 0 {
+		self.advanceFrames()
+		self.Image = self.Frames[self.Frame]
+	}
+
 	if self.Image == nil {
 		return
 	}
 
-	bufWidth := self.Inner.Dx()
-	bufHeight := self.Inner.Dy()
-	imageWidth := self.Image.Bounds().Dx()
-	imageHeight := self.Image.Bounds().Dy()
+	columns := self.Inner.Dx()
+	rows := self.Inner.Dy()
+
+	if self.PixelGraphics && Graphics != GraphicsNone {
+		bitmap, cells := self.scaledBitmap(columns, rows)
+		buf.AddBitmap(bitmap, cells.Add(self.Inner.Min))
+	}
+
+	switch self.Renderer {
+	case ImageBraille:
+		self.drawBraille(buf, columns, rows)
+		return
+	case ImageHalfBlocks:
+		self.drawHalfBlocks(buf, columns, rows)
+		return
+	}
 
 	if self.Monochrome {
-		if bufWidth > imageWidth/2 {
-			bufWidth = imageWidth / 2
-		}
-		if bufHeight > imageHeight/2 {
-			bufHeight = imageHeight / 2
-		}
-		for bx := 0; bx < bufWidth; bx++ {
-			for by := 0; by < bufHeight; by++ {
-				ul := self.colorAverage(
-					2*bx*imageWidth/bufWidth/2,
-					(2*bx+1)*imageWidth/bufWidth/2,
-					2*by*imageHeight/bufHeight/2,
-					(2*by+1)*imageHeight/bufHeight/2,
-				)
-				ur := self.colorAverage(
-					(2*bx+1)*imageWidth/bufWidth/2,
-					(2*bx+2)*imageWidth/bufWidth/2,
-					2*by*imageHeight/bufHeight/2,
-					(2*by+1)*imageHeight/bufHeight/2,
-				)
-				ll := self.colorAverage(
-					2*bx*imageWidth/bufWidth/2,
-					(2*bx+1)*imageWidth/bufWidth/2,
-					(2*by+1)*imageHeight/bufHeight/2,
-					(2*by+2)*imageHeight/bufHeight/2,
-				)
-				lr := self.colorAverage(
-					(2*bx+1)*imageWidth/bufWidth/2,
-					(2*bx+2)*imageWidth/bufWidth/2,
-					(2*by+1)*imageHeight/bufHeight/2,
-					(2*by+2)*imageHeight/bufHeight/2,
-				)
+		grid := self.grid(columns, rows, 2, 2)
+		for bx := 0; bx < columns; bx++ {
+			for by := 0; by < rows; by++ {
+				ul := self.sample(grid, 2*bx, 2*by)
+				ur := self.sample(grid, 2*bx+1, 2*by)
+				ll := self.sample(grid, 2*bx, 2*by+1)
+				lr := self.sample(grid, 2*bx+1, 2*by+1)
 				buf.SetCell(
 					NewCell(blocksChar(ul, ur, ll, lr, self.MonochromeThreshold, self.MonochromeInvert)),
 					image.Pt(self.Inner.Min.X+bx, self.Inner.Min.Y+by),
@@ -79,20 +100,13 @@ func (self *Image) Draw(buf *Buffer) {
 			}
 		}
 	} else {
-		if bufWidth > imageWidth {
-			bufWidth = imageWidth
-		}
-		if bufHeight > imageHeight {
-			bufHeight = imageHeight
-		}
-		for bx := 0; bx < bufWidth; bx++ {
-			for by := 0; by < bufHeight; by++ {
-				c := self.colorAverage(
-					bx*imageWidth/bufWidth,
-					(bx+1)*imageWidth/bufWidth,
-					by*imageHeight/bufHeight,
-					(by+1)*imageHeight/bufHeight,
-				)
+		grid := self.grid(columns, rows, 1, 1)
+		for bx := 0; bx < columns; bx++ {
+			for by := 0; by < rows; by++ {
+				c := self.sample(grid, bx, by)
+				if c.count == 0 {
+					continue
+				}
 				buf.SetCell(
 					NewCell(c.ch(), NewStyle(c.fgColor(), ColorBlack)),
 					image.Pt(self.Inner.Min.X+bx, self.Inner.Min.Y+by),
@@ -0,0 +1,134 @@
+package widgets
+
+import (
+	"image"
+	"image/draw"
+	"image/gif"
+	"time"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// defaultFrameDelay replaces frame delays that are too short, like browsers do for GIFs without delays.
+const defaultFrameDelay = 100 * time.Millisecond
+
+// NewAnimatedImage returns an Image that plays the frames of a GIF, honoring its delays
+// and disposal methods. It loops forever unless the GIF has a loop count.
+func NewAnimatedImage(g *gif.GIF) *Image {
+	self := NewImage(nil)
+	self.SetGIF(g)
+	return self
+}
+
+// SetGIF replaces the Frames with the composed frames of a GIF and restarts the animation.
+func (self *Image) SetGIF(g *gif.GIF) {
+	bounds := image.Rect(0, 0, g.Config.Width, g.Config.Height)
+	canvas := image.NewRGBA(bounds)
+	frames := make([]image.Image, len(g.Image))
+	delays := make([]time.Duration, len(g.Image))
+	for i, frame := range g.Image {
+		var previous *image.RGBA
+		disposal := byte(gif.DisposalNone)
+		if i < len(g.Disposal) {
+			disposal = g.Disposal[i]
+		}
+		if disposal == gif.DisposalPrevious {
+			previous = image.NewRGBA(bounds)
+			draw.Draw(previous, bounds, canvas, image.Point{}, draw.Src)
+		}
+
+		draw.Draw(canvas, frame.Bounds(), frame, frame.Bounds().Min, draw.Over)
+		composed := image.NewRGBA(bounds)
+		draw.Draw(composed, bounds, canvas, image.Point{}, draw.Src)
+		frames[i] = composed
+		if i < len(g.Delay) {
+			// GIF delays are in hundredths of a second
+			delays[i] = time.Duration(g.Delay[i]) * 10 * time.Millisecond
+		}
+
+		switch disposal {
+		case gif.DisposalBackground:
+			draw.Draw(canvas, frame.Bounds(), image.Transparent, image.Point{}, draw.Src)
+		case gif.DisposalPrevious:
+			canvas = previous
+		}
+	}
+
+	self.Frames = frames
+	self.Delays = delays
+	// a loop count of 0 loops forever, -1 plays once and n plays n+1 times
+	self.LoopCount = 0
+	if g.LoopCount != 0 {
+		self.LoopCount = MaxInt(g.LoopCount+1, 1)
+	}
+	self.Restart()
+}
+
+// Restart shows the first frame and restarts the animation with the next Draw.
+func (self *Image) Restart() {
+	self.Frame = 0
+	self.loops = 0
+	self.frameStart = time.Time{}
+}
+
+// frameDelay returns how long the given frame is shown.
+func (self *Image) frameDelay(frame int) time.Duration {
+	if frame < len(self.Delays) && self.Delays[frame] > 10*time.Millisecond {
+		return self.Delays[frame]
+	}
+	return defaultFrameDelay
+}
+
+// cycleDuration returns the duration of one loop of the animation.
+func (self *Image) cycleDuration() time.Duration {
+	total := time.Duration(0)
+	for i := range self.Frames {
+		total += self.frameDelay(i)
+	}
+	return total
+}
+
+// finished reports whether the animation has played LoopCount times.
+func (self *Image) finished() bool {
+	return self.LoopCount > 0 && self.loops >= self.LoopCount
+}
+
+// NextFrame shows the next frame, wrapping around after the last frame until the
+// animation has played LoopCount times, and restarts the delay of the frame.
+func (self *Image) NextFrame() {
+	self.nextFrame()
+	self.frameStart = DefaultClock.Now()
+}
+
+func (self *Image) nextFrame() {
+	if len(self.Frames) == 0 || self.finished() {
+		return
+	}
+	if self.Frame+1 < len(self.Frames) {
+		self.Frame++
+		return
+	}
+	self.loops++
+	if !self.finished() {
+		self.Frame = 0
+	}
+}
+
+// advanceFrames skips the frames whose delays have elapsed since the last Draw.
+func (self *Image) advanceFrames() {
+	now := DefaultClock.Now()
+	if self.frameStart.IsZero() || self.Paused {
+		self.frameStart = now
+	}
+	// skip whole loops at once after long pauses between draws
+	if cycle := self.cycleDuration(); self.LoopCount == 0 && self.Frame == 0 && cycle > 0 {
+		if elapsed := now.Sub(self.frameStart); elapsed >= cycle {
+			self.frameStart = self.frameStart.Add(elapsed / cycle * cycle)
+		}
+	}
+	for !self.finished() && now.Sub(self.frameStart) >= self.frameDelay(self.Frame) {
+		self.frameStart = self.frameStart.Add(self.frameDelay(self.Frame))
+		self.nextFrame()
+	}
+	self.Frame = MaxInt(MinInt(self.Frame, len(self.Frames)-1), 0)
+}
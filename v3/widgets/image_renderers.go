@@ -0,0 +1,110 @@
+package widgets
+
+import (
+	"image"
+	"image/color"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// ImageRenderer is how an Image is drawn with cells if it isn't drawn as a bitmap.
+type ImageRenderer uint
+
+const (
+	// ImageBlocks draws a shaded block per pixel, or quadrant blocks if Monochrome is set.
+	ImageBlocks ImageRenderer = iota
+	// ImageBraille draws 2x4 braille dots per cell, dithered to monochrome.
+	ImageBraille
+	// ImageHalfBlocks draws 2 vertically stacked pixels per cell in 256 colors.
+	ImageHalfBlocks
+)
+
+// drawBraille draws the Image into columns x rows cells of braille dots, with Floyd-Steinberg
+// dithering of the gray values.
+func (self *Image) drawBraille(buf *Buffer, columns, rows int) {
+	if columns <= 0 || rows <= 0 {
+		return
+	}
+	grid := self.grid(columns, rows, 2, 4)
+	width, height := grid.width, grid.height
+	// ink is the amount of every dot, which is 255 for a dot that is set.
+	// Dots outside of the Image are empty and don't take any error.
+	ink := make([][]float64, height)
+	outside := make([][]bool, height)
+	for y := range ink {
+		ink[y] = make([]float64, width)
+		outside[y] = make([]bool, width)
+		for x := range ink[y] {
+			c := self.sample(grid, x, y)
+			gray := float64(color.GrayModel.Convert(c).(color.Gray).Y)
+			switch {
+			case c.count == 0:
+				outside[y][x] = true
+			case self.MonochromeInvert:
+				ink[y][x] = gray
+			default:
+				ink[y][x] = 255 - gray
+			}
+		}
+	}
+
+	diffuse := func(x, y int, err float64) {
+		if x >= 0 && x < width && y < height && !outside[y][x] {
+			ink[y][x] += err
+		}
+	}
+	cells := make([][]rune, rows)
+	for y := range cells {
+		cells[y] = make([]rune, columns)
+		for x := range cells[y] {
+			cells[y][x] = BRAILLE_OFFSET
+		}
+	}
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			old := ink[y][x]
+			quantized := 0.0
+			if old > 255-float64(self.MonochromeThreshold) {
+				quantized = 255
+				cells[y/4][x/2] |= BRAILLE[y%4][x%2]
+			}
+			err := old - quantized
+			diffuse(x+1, y, err*7/16)
+			diffuse(x-1, y+1, err*3/16)
+			diffuse(x, y+1, err*5/16)
+			diffuse(x+1, y+1, err*1/16)
+		}
+	}
+
+	for y, row := range cells {
+		for x, char := range row {
+			buf.SetCell(NewCell(char), image.Pt(self.Inner.Min.X+x, self.Inner.Min.Y+y))
+		}
+	}
+}
+
+// drawHalfBlocks draws the Image into columns x rows cells, with the upper pixel as
+// foreground and the lower pixel as background of an upper half block.
+func (self *Image) drawHalfBlocks(buf *Buffer, columns, rows int) {
+	toColor := func(c colorAverager) Color {
+		if c.count == 0 {
+			return ColorClear
+		}
+		r, g, b, _ := c.RGBA()
+		return RGBToColor(uint8(r>>8), uint8(g>>8), uint8(b>>8))
+	}
+	grid := self.grid(columns, rows, 1, 2)
+	for y := 0; y < rows; y++ {
+		for x := 0; x < columns; x++ {
+			upper := self.sample(grid, x, 2*y)
+			lower := self.sample(grid, x, 2*y+1)
+			if upper.count == 0 && lower.count == 0 {
+				continue
+			}
+			buf.SetCell(
+				NewCell(UPPER_HALF_BLOCK, NewStyle(toColor(upper), toColor(lower))),
+				image.Pt(self.Inner.Min.X+x, self.Inner.Min.Y+y),
+			)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package widgets
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// ImageScaling is how an Image is scaled into its Inner area.
+type ImageScaling uint
+
+const (
+	// ImageStretch scales the Image to fill the area, ignoring its aspect ratio.
+	ImageStretch ImageScaling = iota
+	// ImageFit scales the Image to the largest size that fits into the area while keeping its
+	// aspect ratio, leaving the rest of the area empty.
+	ImageFit
+	// ImageFill scales the Image to the smallest size that covers the area while keeping its
+	// aspect ratio, cropping the edges that don't fit.
+	ImageFill
+	// ImageCenter draws the Image unscaled in the center of the area, one pixel per pixel of
+	// a cell, see CellSize.
+	ImageCenter
+)
+
+// ImageSampling is how the colors of a scaled Image are computed from its pixels.
+type ImageSampling uint
+
+const (
+	// ImageSampleAverage averages all pixels covered by a sample.
+	ImageSampleAverage ImageSampling = iota
+	// ImageSampleNearest takes the pixel at the center of a sample.
+	ImageSampleNearest
+	// ImageSampleBilinear interpolates the four pixels around the center of a sample.
+	ImageSampleBilinear
+)
+
+// imageGrid maps the samples of columns x rows cells, each divided into a number of samples,
+// to the pixels of the Image.
+type imageGrid struct {
+	width, height int
+	// imageWidth and imageHeight are the size of the Image in pixels
+	imageWidth, imageHeight float64
+	// scaleX and scaleY are the number of pixels of the Image per sample
+	scaleX, scaleY float64
+	// offsetX and offsetY are the number of samples left and above of the Image
+	offsetX, offsetY float64
+}
+
+// grid returns the imageGrid of columns x rows cells of samplesX x samplesY samples
+// according to the Scaling. The aspect ratio of the samples follows CellSize.
+func (self *Image) grid(columns, rows, samplesX, samplesY int) imageGrid {
+	cell := CellSize()
+	imageWidth := float64(self.Image.Bounds().Dx())
+	imageHeight := float64(self.Image.Bounds().Dy())
+	grid := imageGrid{
+		width:       columns * samplesX,
+		height:      rows * samplesY,
+		imageWidth:  imageWidth,
+		imageHeight: imageHeight,
+	}
+	if grid.width <= 0 || grid.height <= 0 || imageWidth == 0 || imageHeight == 0 {
+		return imageGrid{}
+	}
+	// the size of a sample in pixels of the terminal
+	sampleWidth := float64(cell.X) / float64(samplesX)
+	sampleHeight := float64(cell.Y) / float64(samplesY)
+
+	// scale is the size of a pixel of the Image in pixels of the terminal
+	scale := 1.0
+	switch self.Scaling {
+	case ImageStretch:
+		grid.scaleX = imageWidth / float64(grid.width)
+		grid.scaleY = imageHeight / float64(grid.height)
+		return grid
+	case ImageFit:
+		scale = MinFloat64(float64(grid.width)*sampleWidth/imageWidth, float64(grid.height)*sampleHeight/imageHeight)
+	case ImageFill:
+		scale = MaxFloat64(float64(grid.width)*sampleWidth/imageWidth, float64(grid.height)*sampleHeight/imageHeight)
+	}
+	grid.scaleX = sampleWidth / scale
+	grid.scaleY = sampleHeight / scale
+	grid.offsetX = (float64(grid.width) - imageWidth/grid.scaleX) / 2
+	grid.offsetY = (float64(grid.height) - imageHeight/grid.scaleY) / 2
+	return grid
+}
+
+// sample returns the color of the sample at x, y of the grid according to the Sampling.
+// Samples outside of the Image are empty, their count is 0.
+func (self *Image) sample(grid imageGrid, x, y int) colorAverager {
+	imageWidth, imageHeight := grid.imageWidth, grid.imageHeight
+	x0 := (float64(x) - grid.offsetX) * grid.scaleX
+	x1 := (float64(x+1) - grid.offsetX) * grid.scaleX
+	y0 := (float64(y) - grid.offsetY) * grid.scaleY
+	y1 := (float64(y+1) - grid.offsetY) * grid.scaleY
+	centerX, centerY := (x0+x1)/2, (y0+y1)/2
+
+	switch self.Sampling {
+	case ImageSampleNearest:
+		if centerX < 0 || centerX >= imageWidth || centerY < 0 || centerY >= imageHeight {
+			return colorAverager{}
+		}
+		return colorAverager{}.add(self.pixel(int(centerX), int(centerY)))
+	case ImageSampleBilinear:
+		if centerX < 0 || centerX >= imageWidth || centerY < 0 || centerY >= imageHeight {
+			return colorAverager{}
+		}
+		return self.bilinear(centerX-0.5, centerY-0.5)
+	}
+
+	x0, x1 = MaxFloat64(x0, 0), MinFloat64(x1, imageWidth)
+	y0, y1 = MaxFloat64(y0, 0), MinFloat64(y1, imageHeight)
+	if x1 <= x0 || y1 <= y0 {
+		return colorAverager{}
+	}
+	// every sample covers at least one pixel, even if the Image is scaled up
+	left, top := int(x0), int(y0)
+	return self.colorAverage(
+		left, MaxInt(int(math.Ceil(x1)), left+1),
+		top, MaxInt(int(math.Ceil(y1)), top+1),
+	)
+}
+
+// pixel returns the color of the pixel at x, y relative to the bounds of the Image,
+// clamped to the bounds.
+func (self *Image) pixel(x, y int) color.Color {
+	bounds := self.Image.Bounds()
+	return self.Image.At(
+		bounds.Min.X+MaxInt(MinInt(x, bounds.Dx()-1), 0),
+		bounds.Min.Y+MaxInt(MinInt(y, bounds.Dy()-1), 0),
+	)
+}
+
+// bilinear returns the color at x, y interpolated between the centers of the
+// four pixels around it.
+func (self *Image) bilinear(x, y float64) colorAverager {
+	left, top := math.Floor(x), math.Floor(y)
+	fx, fy := x-left, y-top
+	var sums [4]float64
+	for _, corner := range []struct {
+		dx, dy int
+		weight float64
+	}{
+		{0, 0, (1 - fx) * (1 - fy)},
+		{1, 0, fx * (1 - fy)},
+		{0, 1, (1 - fx) * fy},
+		{1, 1, fx * fy},
+	} {
+		r, g, b, a := self.pixel(int(left)+corner.dx, int(top)+corner.dy).RGBA()
+		for i, channel := range []uint32{r, g, b, a} {
+			sums[i] += float64(channel) * corner.weight
+		}
+	}
+	return colorAverager{
+		rsum:  uint64(sums[0] + 0.5),
+		gsum:  uint64(sums[1] + 0.5),
+		bsum:  uint64(sums[2] + 0.5),
+		asum:  uint64(sums[3] + 0.5),
+		count: 1,
+	}
+}
+
+// cells returns the cells of the grid with samplesX x samplesY samples per cell that the
+// Image covers.
+func (self imageGrid) cells(samplesX, samplesY int) image.Rectangle {
+	if self.scaleX == 0 || self.scaleY == 0 {
+		return image.Rectangle{}
+	}
+	columns, rows := self.width/samplesX, self.height/samplesY
+	// the size of the scaled Image in samples
+	width := self.imageWidth / self.scaleX
+	height := self.imageHeight / self.scaleY
+	return image.Rect(
+		MaxInt(int(math.Floor(self.offsetX/float64(samplesX))), 0),
+		MaxInt(int(math.Floor(self.offsetY/float64(samplesY))), 0),
+		MinInt(int(math.Ceil((self.offsetX+width)/float64(samplesX))), columns),
+		MinInt(int(math.Ceil((self.offsetY+height)/float64(samplesY))), rows),
+	)
+}
+
+// scaledBitmap returns the part of the Image scaled into columns x rows cells that covers
+// the returned cells, with one sample per pixel of the terminal, so that bitmaps follow the
+// Scaling and Sampling as well. The area outside of the Image is transparent.
+func (self *Image) scaledBitmap(columns, rows int) (image.Image, image.Rectangle) {
+	cell := CellSize()
+	grid := self.grid(columns, rows, cell.X, cell.Y)
+	cells := grid.cells(cell.X, cell.Y)
+	bounds := image.Rect(cells.Min.X*cell.X, cells.Min.Y*cell.Y, cells.Max.X*cell.X, cells.Max.Y*cell.Y)
+	scaled := image.NewRGBA(bounds)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if c := self.sample(grid, x, y); c.count != 0 {
+				scaled.Set(x, y, c)
+			}
+		}
+	}
+	return scaled, cells
+}
@@ -11,6 +11,17 @@ import (
 	. "github.com/s-westphal/termui/v3"
 )
 
+// ListItem is a row of a List with a Title and an optional right aligned Detail,
+// e.g. the size of a file. Style overrides the TextStyle of the List if set.
+// Section makes the item a header for the items following it, which can't be selected
+// and sticks to the top while scrolling through its section.
+type ListItem struct {
+	Title   string
+	Detail  string
+	Style   *Style
+	Section bool
+}
+
 type List struct {
 	Block
 	Rows             []string
@@ -19,6 +30,53 @@ type List struct {
 	SelectedRow      int
 	topRow           int
 	SelectedRowStyle Style
+	// selectionRect is the area of the selected row as of the last Draw.
+	selectionRect image.Rectangle
+
+	// WrapMode is where rows are broken if WrapText is set. Hyphenate adds a hyphen to
+	// words that are broken up.
+	WrapMode  WrapMode
+	Hyphenate bool
+
+	// Items replace Rows if set, adding right aligned detail text and per item styles.
+	Items []ListItem
+	// DataProvider replaces Rows and Items if set.
+	DataProvider ListDataProvider
+
+	// MultiSelect prefixes the rows with checkboxes, which are toggled with ToggleSelected.
+	// Checked holds the indices of the checked rows.
+	MultiSelect bool
+	Checked     map[int]bool
+
+	// Query hides the rows that don't match it according to Matcher, which defaults to
+	// SubstringMatcher, and highlights the matched runes with MatchStyle.
+	// SelectedRow stays the index of the row in Rows.
+	Query      string
+	Matcher    ListMatcher
+	MatchStyle Style
+
+	// SectionStyle is the style of section headers, see ListItem.
+	SectionStyle Style
+
+	// Truncation is where rows that are too long are cut off if WrapText is false.
+	// HorizontalScroll cuts them off at the right edge instead and allows scrolling
+	// them with ScrollLeft and ScrollRight.
+	Truncation       ListTruncation
+	HorizontalScroll bool
+
+	// OnReorder is called when a row was moved, see MoveRow and HandleDragEvent.
+	OnReorder func(from, to int)
+
+	// Scrollbar replaces the arrows at the right edge if set, e.g. with NewScrollbar().
+	Scrollbar *Scrollbar
+
+	// ANSI interprets embedded SGR escape sequences in the rows instead of the embedded
+	// style syntax, see ParseANSI.
+	ANSI bool
+
+	dragging bool
+	// leftColumn is the number of cells scrolled out of view on the left.
+	leftColumn int
 }
 
 func NewList() *List {
@@ -26,36 +84,132 @@ func NewList() *List {
 		Block:            *NewBlock(),
 		TextStyle:        Theme.List.Text,
 		SelectedRowStyle: Theme.List.Text,
+		Checked:          make(map[int]bool),
+		MatchStyle:       Theme.List.Match,
+		SectionStyle:     Theme.List.Section,
+	}
+}
+
+// contentMaxX returns the right edge of the rows, which leave room for the Scrollbar.
+func (self *List) contentMaxX() int {
+	if self.Scrollbar != nil {
+		return self.Inner.Max.X - 1
+	}
+	return self.Inner.Max.X
+}
+
+// itemStyle returns the text style of an item.
+func (self *List) itemStyle(item ListItem) Style {
+	if item.Style != nil {
+		return *item.Style
+	}
+	if item.Section {
+		return self.SectionStyle
+	}
+	return self.TextStyle
+}
+
+// parseText returns the cells of a title or detail.
+func (self *List) parseText(text string, style Style) []Cell {
+	if self.ANSI {
+		return ParseANSI(text, style)
+	}
+	return ParseStyles(text, style)
+}
+
+// parseLinks returns the hyperlinks of a title or detail.
+func (self *List) parseLinks(text string) []Link {
+	if self.ANSI {
+		return ParseANSILinks(text)
+	}
+	return ParseStyleLinks(text)
+}
+
+// rowCells returns the cells of a row, with the matches of the Query highlighted
+// and prefixed with a checkbox in MultiSelect mode.
+func (self *List) rowCells(row int) []Cell {
+	item := self.item(row)
+	textStyle := self.itemStyle(item)
+	cells := self.parseText(item.Title, textStyle)
+	_, matches := self.match(cells)
+	for _, i := range matches {
+		if i < len(cells) {
+			cells[i].Style = self.MatchStyle
+		}
+	}
+	if self.MultiSelect && !item.Section {
+		checkbox := listUnchecked
+		if self.Checked[row] {
+			checkbox = listChecked
+		}
+		cells = append(RunesToStyledCells([]rune(checkbox), textStyle), cells...)
 	}
+	return cells
 }
 
 func (self *List) Draw(buf *Buffer) {
 	self.Block.Draw(buf)
+	self.selectionRect = image.Rectangle{}
 
 	point := self.Inner.Min
 
+	rows := self.visibleRows()
+	position := self.selectablePosition(rows, self.selectedPosition(rows), 1)
+	if rows.Len() > 0 {
+		self.SelectedRow = rows.At(position)
+	}
+
 	// adjusts view into widget
-	if self.SelectedRow >= self.Inner.Dy()+self.topRow {
-		self.topRow = self.SelectedRow - self.Inner.Dy() + 1
-	} else if self.SelectedRow < self.topRow {
-		self.topRow = self.SelectedRow
+	if position >= self.Inner.Dy()+self.topRow {
+		self.topRow = position - self.Inner.Dy() + 1
+	} else if position < self.topRow {
+		self.topRow = position
+	}
+	// don't hide the selected row behind a sticky section header
+	if position == self.topRow && self.stickySection(rows) >= 0 {
+		self.topRow--
+	}
+
+	if self.HorizontalScroll {
+		self.clampLeftColumn(rows)
 	}
 
 	// draw rows
-	for row := self.topRow; row < len(self.Rows) && point.Y < self.Inner.Max.Y; row++ {
-		cells := ParseStyles(self.Rows[row], self.TextStyle)
+	for k := self.topRow; k < rows.Len() && point.Y < self.Inner.Max.Y; k++ {
+		row := rows.At(k)
+		rowY := point.Y
+		if row == self.SelectedRow {
+			self.selectionRect = image.Rect(self.Inner.Min.X, rowY, self.Inner.Max.X, rowY+1)
+		}
+		item := self.item(row)
+		textStyle := self.itemStyle(item)
+		cells := self.rowCells(row)
+		// the detail is drawn right aligned on the first line of the row
+		detail := self.parseText(item.Detail, textStyle)
+		maxX := self.contentMaxX()
+		if len(detail) > 0 {
+			maxX = MaxInt(maxX-len(detail)-1, self.Inner.Min.X+1)
+			for i, cell := range detail {
+				if row == self.SelectedRow {
+					cell.Style = self.SelectedRowStyle
+				}
+				buf.SetCell(cell, image.Pt(maxX+1+i, point.Y))
+			}
+		}
 		if self.WrapText {
-			cells = WrapCells(cells, uint(self.Inner.Dx()))
+			cells = WrapCellsWith(cells, maxX-self.Inner.Min.X, self.WrapMode, self.Hyphenate)
+		} else {
+			cells = self.fitCells(cells, maxX-self.Inner.Min.X)
 		}
 		for j := 0; j < len(cells) && point.Y < self.Inner.Max.Y; j++ {
 			style := cells[j].Style
-			if row == self.SelectedRow {
+			if row == self.SelectedRow && style != self.MatchStyle {
 				style = self.SelectedRowStyle
 			}
 			if cells[j].Rune == '\n' {
 				point = image.Pt(self.Inner.Min.X, point.Y+1)
 			} else {
-				if point.X+1 == self.Inner.Max.X+1 && len(cells) > self.Inner.Dx() {
+				if point.X == maxX && len(cells) > maxX-self.Inner.Min.X {
 					buf.SetCell(NewCell(ELLIPSES, style), point.Add(image.Pt(-1, 0)))
 					break
 				} else {
@@ -65,6 +219,17 @@ func (self *List) Draw(buf *Buffer) {
 			}
 		}
 		point = image.Pt(self.Inner.Min.X, point.Y+1)
+		links := append(self.parseLinks(item.Title), self.parseLinks(item.Detail)...)
+		buf.SetLinks(links, image.Rect(self.Inner.Min.X, rowY, self.Inner.Max.X, point.Y))
+	}
+
+	if section := self.stickySection(rows); section >= 0 {
+		self.drawStickySection(buf, section)
+	}
+
+	if self.Scrollbar != nil {
+		self.Scrollbar.Draw(buf, self.Inner.Max.X-1, self.Inner.Min.Y, self.Inner.Max.Y, rows.Len(), self.topRow, self.Inner.Dy())
+		return
 	}
 
 	// draw UP_ARROW if needed
@@ -76,7 +241,7 @@ func (self *List) Draw(buf *Buffer) {
 	}
 
 	// draw DOWN_ARROW if needed
-	if len(self.Rows) > int(self.topRow)+self.Inner.Dy() {
+	if rows.Len() > self.topRow+self.Inner.Dy() {
 		buf.SetCell(
 			NewCell(DOWN_ARROW, NewStyle(ColorWhite)),
 			image.Pt(self.Inner.Max.X-1, self.Inner.Max.Y-1),
@@ -84,17 +249,26 @@ func (self *List) Draw(buf *Buffer) {
 	}
 }
 
+// SelectionRect returns the area of the first line of the SelectedRow as of the last Draw,
+// which is empty if it isn't visible.
+func (self *List) SelectionRect() image.Rectangle {
+	return self.selectionRect
+}
+
 // ScrollAmount scrolls by amount given. If amount is < 0, then scroll up.
 // There is no need to set self.topRow, as this will be set automatically when drawn,
 // since if the selected item is off screen then the topRow variable will change accordingly.
 func (self *List) ScrollAmount(amount int) {
-	if len(self.Rows)-int(self.SelectedRow) <= amount {
-		self.SelectedRow = len(self.Rows) - 1
-	} else if int(self.SelectedRow)+amount < 0 {
-		self.SelectedRow = 0
-	} else {
-		self.SelectedRow += amount
+	rows := self.visibleRows()
+	if rows.Len() == 0 {
+		return
+	}
+	step := 1
+	if amount < 0 {
+		step = -1
 	}
+	position := self.selectablePosition(rows, self.selectedPosition(rows)+amount, step)
+	self.SelectedRow = rows.At(position)
 }
 
 func (self *List) ScrollUp() {
@@ -107,8 +281,9 @@ func (self *List) ScrollDown() {
 
 func (self *List) ScrollPageUp() {
 	// If an item is selected below top row, then go to the top row.
-	if self.SelectedRow > self.topRow {
-		self.SelectedRow = self.topRow
+	rows := self.visibleRows()
+	if position := self.selectedPosition(rows); position > self.topRow && self.topRow < rows.Len() {
+		self.SelectedRow = rows.At(self.topRow)
 	} else {
 		self.ScrollAmount(-self.Inner.Dy())
 	}
@@ -128,8 +303,10 @@ func (self *List) ScrollHalfPageDown() {
 
 func (self *List) ScrollTop() {
 	self.SelectedRow = 0
+	self.ScrollAmount(0)
 }
 
 func (self *List) ScrollBottom() {
-	self.SelectedRow = len(self.Rows) - 1
+	self.SelectedRow = self.rowCount() - 1
+	self.ScrollAmount(0)
 }
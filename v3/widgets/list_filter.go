@@ -0,0 +1,88 @@
+package widgets
+
+import (
+	"unicode"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// ListMatcher reports whether text matches query and returns the indices of the matched runes.
+type ListMatcher func(text, query string) (bool, []int)
+
+// SubstringMatcher matches rows containing the query, ignoring case.
+func SubstringMatcher(text, query string) (bool, []int) {
+	haystack, needle := []rune(text), []rune(query)
+	for i := 0; i+len(needle) <= len(haystack); i++ {
+		found := true
+		for j, r := range needle {
+			if unicode.ToLower(haystack[i+j]) != unicode.ToLower(r) {
+				found = false
+				break
+			}
+		}
+		if found {
+			indices := make([]int, len(needle))
+			for j := range needle {
+				indices[j] = i + j
+			}
+			return true, indices
+		}
+	}
+	return false, nil
+}
+
+// FuzzyMatcher matches rows containing the runes of the query in order, ignoring case,
+// e.g. "lvw" matches "LogViewer".
+func FuzzyMatcher(text, query string) (bool, []int) {
+	needle := []rune(query)
+	indices := make([]int, 0, len(needle))
+	for i, r := range []rune(text) {
+		if len(indices) < len(needle) && unicode.ToLower(r) == unicode.ToLower(needle[len(indices)]) {
+			indices = append(indices, i)
+		}
+	}
+	return len(indices) == len(needle), indices
+}
+
+// match returns whether the row matches the Query and the indices of the matched cells.
+func (self *List) match(cells []Cell) (bool, []int) {
+	if self.Query == "" {
+		return true, nil
+	}
+	matcher := self.Matcher
+	if matcher == nil {
+		matcher = SubstringMatcher
+	}
+	return matcher(CellsToString(cells), self.Query)
+}
+
+// visibleRows returns the rows matching the Query, together with their section headers.
+func (self *List) visibleRows() rowIndex {
+	count := self.rowCount()
+	if self.Query == "" {
+		return rowIndex{count: count}
+	}
+	rows := make([]int, 0, count)
+	// section headers are shown if any row of their section matches
+	section := -1
+	for i := 0; i < count; i++ {
+		if self.isSection(i) {
+			section = i
+			continue
+		}
+		if ok, _ := self.match(self.parseText(self.row(i), self.TextStyle)); ok {
+			if section >= 0 {
+				rows = append(rows, section)
+				section = -1
+			}
+			rows = append(rows, i)
+		}
+	}
+	return rowIndex{indices: rows}
+}
+
+// selectedPosition returns the position of the SelectedRow in rows. If the SelectedRow
+// is hidden, the position of the next visible row is returned instead.
+func (self *List) selectedPosition(rows rowIndex) int {
+	return rows.Position(self.SelectedRow)
+}
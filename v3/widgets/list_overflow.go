@@ -0,0 +1,99 @@
+package widgets
+
+import (
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// ListTruncation is where a List cuts off rows that are too long.
+type ListTruncation uint
+
+const (
+	ListTruncateRight ListTruncation = iota
+	ListTruncateLeft
+	ListTruncateMiddle
+)
+
+// cellsWidth returns the width of the cells in terminal columns.
+func cellsWidth(cells []Cell) int {
+	width := 0
+	for _, cell := range cells {
+		width += rw.RuneWidth(cell.Rune)
+	}
+	return width
+}
+
+// fitCells cuts off the cells so that they fit into width, marking the cut with an ellipsis.
+func (self *List) fitCells(cells []Cell, width int) []Cell {
+	if width <= 0 {
+		return []Cell{}
+	}
+	if self.HorizontalScroll {
+		offset := MinInt(self.leftColumn, len(cells))
+		scrolled := append([]Cell{}, cells[offset:]...)
+		if offset > 0 && len(scrolled) > 0 {
+			scrolled[0].Rune = ELLIPSES
+		}
+		if cellsWidth(scrolled) > width {
+			scrolled = append(takeCells(scrolled, width-1), NewCell(ELLIPSES, scrolled[0].Style))
+		}
+		return scrolled
+	}
+	if cellsWidth(cells) <= width {
+		return cells
+	}
+
+	switch self.Truncation {
+	case ListTruncateLeft:
+		right := takeCellsFromEnd(cells, width-1)
+		return append([]Cell{NewCell(ELLIPSES, cells[len(cells)-len(right)-1].Style)}, right...)
+	case ListTruncateMiddle:
+		left := takeCells(cells, (width-1)/2)
+		right := takeCellsFromEnd(cells, width-1-cellsWidth(left))
+		return append(append(left, NewCell(ELLIPSES, cells[len(left)].Style)), right...)
+	}
+	left := takeCells(cells, width-1)
+	return append(left, NewCell(ELLIPSES, cells[len(left)].Style))
+}
+
+// takeCells returns the leading cells that fit into width.
+func takeCells(cells []Cell, width int) []Cell {
+	taken := []Cell{}
+	for _, cell := range cells {
+		if width -= rw.RuneWidth(cell.Rune); width < 0 {
+			break
+		}
+		taken = append(taken, cell)
+	}
+	return taken
+}
+
+// takeCellsFromEnd returns the trailing cells that fit into width.
+func takeCellsFromEnd(cells []Cell, width int) []Cell {
+	start := len(cells)
+	for ; start > 0; start-- {
+		if width -= rw.RuneWidth(cells[start-1].Rune); width < 0 {
+			break
+		}
+	}
+	return append([]Cell{}, cells[start:]...)
+}
+
+// clampLeftColumn limits the horizontal scroll position to the width of the longest visible row.
+func (self *List) clampLeftColumn(rows rowIndex) {
+	longest := 0
+	for k := self.topRow; k < rows.Len() && k < self.topRow+self.Inner.Dy(); k++ {
+		longest = MaxInt(longest, len(self.rowCells(rows.At(k))))
+	}
+	self.leftColumn = MaxInt(MinInt(self.leftColumn, longest-(self.contentMaxX()-self.Inner.Min.X)), 0)
+}
+
+// ScrollLeft scrolls the rows one cell to the left if HorizontalScroll is enabled.
+func (self *List) ScrollLeft() {
+	self.leftColumn = MaxInt(self.leftColumn-1, 0)
+}
+
+// ScrollRight scrolls the rows one cell to the right if HorizontalScroll is enabled.
+func (self *List) ScrollRight() {
+	self.leftColumn++
+}
@@ -0,0 +1,35 @@
+package widgets
+
+// ListDataProvider supplies the rows of a List on demand instead of Rows, so that
+// e.g. huge logs or directory listings can be browsed without building a []string.
+type ListDataProvider interface {
+	ItemCount() int
+	Item(i int) string
+}
+
+// rowCount returns the number of rows.
+func (self *List) rowCount() int {
+	if self.DataProvider != nil {
+		return self.DataProvider.ItemCount()
+	}
+	if self.Items != nil {
+		return len(self.Items)
+	}
+	return len(self.Rows)
+}
+
+// row returns the text of the row with the given index.
+func (self *List) row(i int) string {
+	return self.item(i).Title
+}
+
+// item returns the row with the given index as ListItem.
+func (self *List) item(i int) ListItem {
+	if self.DataProvider != nil {
+		return ListItem{Title: self.DataProvider.Item(i)}
+	}
+	if self.Items != nil {
+		return self.Items[i]
+	}
+	return ListItem{Title: self.Rows[i]}
+}
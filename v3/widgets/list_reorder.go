@@ -0,0 +1,112 @@
+package widgets
+
+import (
+	"image"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// MoveRow moves the row at index from to index to, shifting the rows in between,
+// and calls OnReorder. Checked rows and the SelectedRow move along.
+// Rows can't be reordered if they come from a DataProvider.
+func (self *List) MoveRow(from, to int) {
+	count := self.rowCount()
+	if self.DataProvider != nil || from == to || from < 0 || to < 0 || from >= count || to >= count {
+		return
+	}
+
+	// newIndex maps the old row indices to the new ones
+	newIndex := func(i int) int {
+		switch {
+		case i == from:
+			return to
+		case from < to && i > from && i <= to:
+			return i - 1
+		case to < from && i >= to && i < from:
+			return i + 1
+		}
+		return i
+	}
+
+	if self.Items != nil {
+		item := self.Items[from]
+		self.Items = append(self.Items[:from], self.Items[from+1:]...)
+		self.Items = append(self.Items[:to], append([]ListItem{item}, self.Items[to:]...)...)
+	} else {
+		row := self.Rows[from]
+		self.Rows = append(self.Rows[:from], self.Rows[from+1:]...)
+		self.Rows = append(self.Rows[:to], append([]string{row}, self.Rows[to:]...)...)
+	}
+	checked := make(map[int]bool)
+	for i, c := range self.Checked {
+		checked[newIndex(i)] = c
+	}
+	self.Checked = checked
+	self.SelectedRow = newIndex(self.SelectedRow)
+
+	if self.OnReorder != nil {
+		self.OnReorder(from, to)
+	}
+}
+
+// MoveSelectedUp moves the SelectedRow above the previous visible row.
+func (self *List) MoveSelectedUp() {
+	self.moveSelected(-1)
+}
+
+// MoveSelectedDown moves the SelectedRow below the next visible row.
+func (self *List) MoveSelectedDown() {
+	self.moveSelected(1)
+}
+
+func (self *List) moveSelected(amount int) {
+	rows := self.visibleRows()
+	if rows.Len() == 0 {
+		return
+	}
+	position := MaxInt(MinInt(self.selectedPosition(rows)+amount, rows.Len()-1), 0)
+	self.MoveRow(self.SelectedRow, rows.At(position))
+}
+
+// rowAt returns the index of the row drawn at the given point, or -1 if there is none.
+// Wrapped rows take up a varying number of lines and aren't supported.
+func (self *List) rowAt(p image.Point) int {
+	if self.WrapText || !p.In(self.Inner) {
+		return -1
+	}
+	rows := self.visibleRows()
+	if position := self.topRow + p.Y - self.Inner.Min.Y; position < rows.Len() {
+		return rows.At(position)
+	}
+	return -1
+}
+
+// HandleDragEvent lets rows be grabbed with the left mouse button and dragged to a new position.
+// It returns whether the event was used.
+func (self *List) HandleDragEvent(e Event) bool {
+	mouse, ok := e.Payload.(Mouse)
+	if e.Type != MouseEvent || !ok {
+		return false
+	}
+	switch e.ID {
+	case "<MouseLeft>":
+		row := self.rowAt(image.Pt(mouse.X, mouse.Y))
+		if !mouse.Drag || !self.dragging {
+			if row < 0 {
+				return false
+			}
+			self.SelectedRow = row
+			self.dragging = true
+			return true
+		}
+		if row >= 0 {
+			self.MoveRow(self.SelectedRow, row)
+		}
+		return true
+	case "<MouseRelease>":
+		dragging := self.dragging
+		self.dragging = false
+		return dragging
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+package widgets
+
+import (
+	"image"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// isSection returns whether the row is a section header, which can't be selected.
+func (self *List) isSection(row int) bool {
+	return self.DataProvider == nil && self.Items != nil && row >= 0 && row < len(self.Items) && self.Items[row].Section
+}
+
+// sectionOf returns the index of the section header of the given row, or -1 if there is none.
+func (self *List) sectionOf(row int) int {
+	for i := row; i >= 0; i-- {
+		if self.isSection(i) {
+			return i
+		}
+	}
+	return -1
+}
+
+// selectablePosition returns the position of the first row from position on that isn't
+// a section header, searching in the direction of step first and then in the other one.
+func (self *List) selectablePosition(rows rowIndex, position, step int) int {
+	position = MaxInt(MinInt(position, rows.Len()-1), 0)
+	for _, direction := range []int{step, -step} {
+		for p := position; p >= 0 && p < rows.Len(); p += direction {
+			if !self.isSection(rows.At(p)) {
+				return p
+			}
+		}
+	}
+	return position
+}
+
+// stickySection returns the section header of the top row if it is scrolled out of view, or -1.
+func (self *List) stickySection(rows rowIndex) int {
+	if self.topRow >= rows.Len() {
+		return -1
+	}
+	top := rows.At(self.topRow)
+	if section := self.sectionOf(top); section != top {
+		return section
+	}
+	return -1
+}
+
+// drawStickySection draws the section header over the first line.
+func (self *List) drawStickySection(buf *Buffer, section int) {
+	y := self.Inner.Min.Y
+	buf.Fill(CellClear, image.Rect(self.Inner.Min.X, y, self.contentMaxX(), y+1))
+	x := self.Inner.Min.X
+	for _, cell := range self.fitCells(self.rowCells(section), self.contentMaxX()-self.Inner.Min.X) {
+		buf.SetCell(cell, image.Pt(x, y))
+		x += rw.RuneWidth(cell.Rune)
+	}
+}
@@ -0,0 +1,48 @@
+package widgets
+
+import (
+	"sort"
+)
+
+const (
+	listChecked   = "[x] "
+	listUnchecked = "[ ] "
+)
+
+// ToggleSelected checks or unchecks the SelectedRow in MultiSelect mode, e.g. when Space is pressed.
+func (self *List) ToggleSelected() {
+	if !self.MultiSelect || self.SelectedRow < 0 || self.SelectedRow >= self.rowCount() || self.isSection(self.SelectedRow) {
+		return
+	}
+	if self.Checked[self.SelectedRow] {
+		delete(self.Checked, self.SelectedRow)
+	} else {
+		self.Checked[self.SelectedRow] = true
+	}
+}
+
+// SelectAll checks all rows.
+func (self *List) SelectAll() {
+	for i := 0; i < self.rowCount(); i++ {
+		if !self.isSection(i) {
+			self.Checked[i] = true
+		}
+	}
+}
+
+// SelectNone unchecks all rows.
+func (self *List) SelectNone() {
+	self.Checked = make(map[int]bool)
+}
+
+// SelectedIndices returns the indices of the checked rows in ascending order.
+func (self *List) SelectedIndices() []int {
+	indices := []int{}
+	for i, checked := range self.Checked {
+		if checked && i < self.rowCount() {
+			indices = append(indices, i)
+		}
+	}
+	sort.Ints(indices)
+	return indices
+}
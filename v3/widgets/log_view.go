@@ -0,0 +1,293 @@
+package widgets
+
+import (
+	"image"
+	"strings"
+	"time"
+	"unicode"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// LogLine is a line of a LogView.
+type LogLine struct {
+	Time time.Time
+	Text string
+	// Severity colors the line with the LevelStyles of the LogView if set, see Severity.Style.
+	Severity *Severity
+}
+
+// LogView shows the last MaxLines appended lines. With Follow, it sticks to the newest
+// line at the bottom. Scrolling up stops following, scrolling back to the bottom with
+// ScrollBottom continues it.
+type LogView struct {
+	Block
+	// MaxLines is the number of lines kept, older lines are dropped.
+	MaxLines int
+	Follow   bool
+
+	// ShowTimestamps prefixes the lines with their Time in TimeFormat.
+	ShowTimestamps bool
+	TimeFormat     string
+
+	TextStyle      Style
+	TimestampStyle Style
+	// LevelStyles override the style of the Severity of lines, see Severity.Style.
+	LevelStyles map[Severity]Style
+	// DetectSeverity finds the Severity of lines appended with Append, e.g. from "ERROR"
+	// or "level=warn". It defaults to DetectLogSeverity, nil disables it.
+	DetectSeverity func(text string) (Severity, bool)
+
+	// SearchText highlights its occurrences with SearchStyle, ignoring case, see Search.
+	SearchText        string
+	SearchStyle       Style
+	CurrentMatchStyle Style
+
+	// Scrollbar replaces the arrows at the right edge if set, e.g. with NewScrollbar().
+	Scrollbar *Scrollbar
+
+	// lines is a ring buffer starting at first once it is full.
+	lines []LogLine
+	first int
+	// topLine is the index of the first line shown.
+	topLine      int
+	currentMatch int
+}
+
+func NewLogView() *LogView {
+	return &LogView{
+		Block:             *NewBlock(),
+		MaxLines:          10000,
+		Follow:            true,
+		TimeFormat:        "15:04:05",
+		TextStyle:         Theme.LogView.Text,
+		TimestampStyle:    Theme.LogView.Timestamp,
+		LevelStyles:       make(map[Severity]Style),
+		DetectSeverity:    DetectLogSeverity,
+		SearchStyle:       Theme.LogView.Match,
+		CurrentMatchStyle: Theme.LogView.CurrentMatch,
+	}
+}
+
+// DetectLogSeverity returns the severity named by the first upper case word of text, e.g.
+// "ERROR" or "WARN", or by a "level=" field, see ParseSeverity.
+func DetectLogSeverity(text string) (Severity, bool) {
+	if i := strings.Index(text, "level="); i >= 0 {
+		field := strings.FieldsFunc(text[i+len("level="):], func(r rune) bool { return !unicode.IsLetter(r) })
+		if len(field) > 0 {
+			if severity, err := ParseSeverity(field[0]); err == nil {
+				return severity, true
+			}
+		}
+	}
+	for _, word := range strings.FieldsFunc(text, func(r rune) bool { return !unicode.IsLetter(r) }) {
+		if len(word) < 3 || strings.ToUpper(word) != word {
+			continue
+		}
+		if severity, err := ParseSeverity(word); err == nil {
+			return severity, true
+		}
+	}
+	return SeverityOK, false
+}
+
+// Len returns the number of lines.
+func (self *LogView) Len() int {
+	return len(self.lines)
+}
+
+// Line returns the line at index i, 0 being the oldest.
+func (self *LogView) Line(i int) LogLine {
+	return self.lines[(self.first+i)%len(self.lines)]
+}
+
+// Lines returns the lines from the oldest to the newest.
+func (self *LogView) Lines() []LogLine {
+	lines := make([]LogLine, len(self.lines))
+	for i := range lines {
+		lines[i] = self.Line(i)
+	}
+	return lines
+}
+
+// Append adds a line with the current time and the severity found by DetectSeverity.
+// Lock the LogView when appending from another goroutine than the one rendering it.
+func (self *LogView) Append(text string) {
+	line := LogLine{Time: DefaultClock.Now(), Text: text}
+	if self.DetectSeverity != nil {
+		if severity, ok := self.DetectSeverity(text); ok {
+			line.Severity = &severity
+		}
+	}
+	self.AppendLine(line)
+}
+
+// AppendLine adds a line, dropping the oldest lines if there are more than MaxLines.
+func (self *LogView) AppendLine(line LogLine) {
+	if self.MaxLines > 0 && len(self.lines) >= self.MaxLines {
+		if len(self.lines) > self.MaxLines {
+			// MaxLines was lowered
+			self.lines = self.Lines()[len(self.lines)-self.MaxLines:]
+			self.first = 0
+		}
+		self.lines[self.first] = line
+		self.first = (self.first + 1) % len(self.lines)
+		// keep the shown lines in place while not following
+		self.topLine = MaxInt(self.topLine-1, 0)
+		return
+	}
+	self.lines = append(self.lines, line)
+}
+
+// Clear removes all lines.
+func (self *LogView) Clear() {
+	self.lines = nil
+	self.first = 0
+	self.topLine = 0
+}
+
+// maxTopLine returns the topLine showing the newest line at the bottom.
+func (self *LogView) maxTopLine() int {
+	return MaxInt(len(self.lines)-self.Inner.Dy(), 0)
+}
+
+// ScrollAmount scrolls by amount lines, down if it is positive. Scrolling up stops
+// following, scrolling to the bottom continues it.
+func (self *LogView) ScrollAmount(amount int) {
+	if self.Follow {
+		self.topLine = self.maxTopLine()
+	}
+	self.topLine = MaxInt(MinInt(self.topLine+amount, self.maxTopLine()), 0)
+	self.Follow = self.topLine == self.maxTopLine()
+}
+
+func (self *LogView) ScrollUp() {
+	self.ScrollAmount(-1)
+}
+
+func (self *LogView) ScrollDown() {
+	self.ScrollAmount(1)
+}
+
+func (self *LogView) ScrollPageUp() {
+	self.ScrollAmount(-self.Inner.Dy())
+}
+
+func (self *LogView) ScrollPageDown() {
+	self.ScrollAmount(self.Inner.Dy())
+}
+
+func (self *LogView) ScrollTop() {
+	self.Follow = false
+	self.topLine = 0
+}
+
+// ScrollBottom shows the newest line and continues following.
+func (self *LogView) ScrollBottom() {
+	self.Follow = true
+}
+
+// lineStyle returns the style of a line according to its Severity.
+func (self *LogView) lineStyle(line LogLine) Style {
+	if line.Severity == nil {
+		return self.TextStyle
+	}
+	if style, ok := self.LevelStyles[*line.Severity]; ok {
+		return style
+	}
+	return line.Severity.Style()
+}
+
+// textCells returns the cells of the Text of a line, with the matches of the SearchText
+// highlighted. match is the index of the first match of the line among all matches.
+func (self *LogView) textCells(line LogLine, match int) []Cell {
+	cells := RunesToStyledCells([]rune(line.Text), self.lineStyle(line))
+	length := len([]rune(self.SearchText))
+	for i, column := range findMatches(cells, self.SearchText) {
+		style := self.SearchStyle
+		if match+i == self.currentMatch {
+			style = self.CurrentMatchStyle
+		}
+		for j := column; j < column+length; j++ {
+			cells[j].Style = style
+		}
+	}
+	return cells
+}
+
+func (self *LogView) Draw(buf *Buffer) {
+	self.Block.Draw(buf)
+
+	if self.Follow {
+		self.topLine = self.maxTopLine()
+	}
+	self.topLine = MaxInt(MinInt(self.topLine, self.maxTopLine()), 0)
+
+	width := self.Inner.Dx()
+	if self.Scrollbar != nil {
+		width--
+	}
+	// the matches before the shown lines are counted to find the current match
+	match := 0
+	if self.SearchText != "" {
+		for i := 0; i < self.topLine; i++ {
+			match += len(findMatches(RunesToStyledCells([]rune(self.Line(i).Text), self.TextStyle), self.SearchText))
+		}
+	}
+	for row := 0; row < self.Inner.Dy() && self.topLine+row < len(self.lines); row++ {
+		line := self.Line(self.topLine + row)
+		cells := []Cell{}
+		if self.ShowTimestamps {
+			cells = RunesToStyledCells([]rune(line.Time.Format(self.TimeFormat)+" "), self.TimestampStyle)
+		}
+		text := self.textCells(line, match)
+		match += len(findMatches(text, self.SearchText))
+		cells = TrimCells(append(cells, text...), width)
+		for _, cx := range BuildCellWithXArray(cells) {
+			buf.SetCell(cx.Cell, image.Pt(self.Inner.Min.X+cx.X, self.Inner.Min.Y+row))
+		}
+	}
+
+	if self.Scrollbar != nil {
+		self.Scrollbar.Draw(buf, self.Inner.Max.X-1, self.Inner.Min.Y, self.Inner.Max.Y, len(self.lines), self.topLine, self.Inner.Dy())
+		return
+	}
+	if self.topLine > 0 {
+		buf.SetCell(NewCell(UP_ARROW, NewStyle(ColorWhite)), image.Pt(self.Inner.Max.X-1, self.Inner.Min.Y))
+	}
+	if self.topLine < self.maxTopLine() {
+		buf.SetCell(NewCell(DOWN_ARROW, NewStyle(ColorWhite)), image.Pt(self.Inner.Max.X-1, self.Inner.Max.Y-1))
+	}
+}
+
+// HandleEvent scrolls with <Up>, <Down>, <PageUp>, <PageDown>, <Home>, <End> and the mouse
+// wheel, toggles Follow with "f" and moves between the matches of the SearchText with
+// "n" and "N". It returns whether the event was used.
+func (self *LogView) HandleEvent(e Event) bool {
+	switch e.ID {
+	case "<Up>", "k", "<MouseWheelUp>":
+		self.ScrollUp()
+	case "<Down>", "j", "<MouseWheelDown>":
+		self.ScrollDown()
+	case "<PageUp>":
+		self.ScrollPageUp()
+	case "<PageDown>":
+		self.ScrollPageDown()
+	case "<Home>", "g":
+		self.ScrollTop()
+	case "<End>", "G":
+		self.ScrollBottom()
+	case "f":
+		if self.Follow {
+			self.topLine = self.maxTopLine()
+		}
+		self.Follow = !self.Follow
+	case "n":
+		self.NextMatch()
+	case "N":
+		self.PrevMatch()
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,77 @@
+package widgets
+
+import (
+	. "github.com/s-westphal/termui/v3"
+)
+
+// matches returns the lines of the matches of the SearchText, from the oldest to the newest.
+func (self *LogView) matches() []int {
+	matches := []int{}
+	if self.SearchText == "" {
+		return matches
+	}
+	for i := range self.lines {
+		cells := RunesToStyledCells([]rune(self.Line(i).Text), self.TextStyle)
+		for range findMatches(cells, self.SearchText) {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+// Search highlights the matches of query, ignoring case, scrolls to the newest one and
+// returns the number of matches.
+func (self *LogView) Search(query string) int {
+	self.SearchText = query
+	count := self.MatchCount()
+	self.currentMatch = MaxInt(count-1, 0)
+	self.scrollToMatch()
+	return count
+}
+
+// MatchCount returns the number of matches of the SearchText.
+func (self *LogView) MatchCount() int {
+	return len(self.matches())
+}
+
+// CurrentMatch returns the index of the current match, which is highlighted with
+// the CurrentMatchStyle.
+func (self *LogView) CurrentMatch() int {
+	return self.currentMatch
+}
+
+// NextMatch makes the next, newer match the current one and scrolls to it,
+// wrapping around after the newest match.
+func (self *LogView) NextMatch() {
+	if count := self.MatchCount(); count > 0 {
+		self.currentMatch = (self.currentMatch + 1) % count
+		self.scrollToMatch()
+	}
+}
+
+// PrevMatch makes the previous, older match the current one and scrolls to it,
+// wrapping around before the oldest match.
+func (self *LogView) PrevMatch() {
+	if count := self.MatchCount(); count > 0 {
+		self.currentMatch = (self.currentMatch - 1 + count) % count
+		self.scrollToMatch()
+	}
+}
+
+// scrollToMatch centers the current match vertically if it isn't visible, which stops
+// following.
+func (self *LogView) scrollToMatch() {
+	matches := self.matches()
+	if len(matches) == 0 {
+		return
+	}
+	self.currentMatch = MinInt(self.currentMatch, len(matches)-1)
+	line := matches[self.currentMatch]
+	if self.Follow {
+		self.topLine = self.maxTopLine()
+		self.Follow = false
+	}
+	if line < self.topLine || line >= self.topLine+self.Inner.Dy() {
+		self.topLine = MaxInt(MinInt(line-self.Inner.Dy()/2, self.maxTopLine()), 0)
+	}
+}
@@ -0,0 +1,307 @@
+package widgets
+
+import (
+	"image"
+	"unicode"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// Menu is a titled dropdown menu of a MenuBar. A '&' in the Title marks the accelerator,
+// which opens the menu with Alt, e.g. "&File" with <M-f>.
+type Menu struct {
+	Title string
+	Items []MenuItem
+}
+
+// MenuBar is a row of menu titles that open dropdown menus, in which items with Items
+// open submenus. Actions of the items are called with a nil selection.
+//
+// Like ContextMenus.Menu, the open menus returned by Popups have to be rendered after all
+// other widgets:
+//
+//	for e := range ui.PollEvents() {
+//		if !bar.HandleEvent(e) {
+//			// handle other events
+//		}
+//		ui.Render(append([]ui.Drawable{bar, grid}, bar.Popups()...)...)
+//	}
+type MenuBar struct {
+	Block
+	Menus         []Menu
+	TextStyle     Style
+	SelectedStyle Style
+
+	// OpenKey is the event ID that opens the first menu, or closes the open one.
+	OpenKey string
+	// Bounds is used to keep the menus on screen if it is not empty.
+	Bounds image.Rectangle
+
+	// open are the open dropdown menu and its open submenus.
+	open   []*ContextMenu
+	active int
+}
+
+func NewMenuBar(menus ...Menu) *MenuBar {
+	self := &MenuBar{
+		Block:         *NewBlock(),
+		Menus:         menus,
+		TextStyle:     Theme.Menu.Text,
+		SelectedStyle: Theme.Menu.Selected,
+		OpenKey:       "<F10>",
+		active:        -1,
+	}
+	self.Border = false
+	return self
+}
+
+// IsOpen reports whether a menu is open.
+func (self *MenuBar) IsOpen() bool {
+	return len(self.open) > 0
+}
+
+// Popups returns the open menus, which have to be rendered after all other widgets.
+func (self *MenuBar) Popups() []Drawable {
+	popups := make([]Drawable, len(self.open))
+	for i, menu := range self.open {
+		popups[i] = menu
+	}
+	return popups
+}
+
+// titleRects returns where the titles of the Menus are drawn.
+func (self *MenuBar) titleRects() []image.Rectangle {
+	rects := make([]image.Rectangle, len(self.Menus))
+	x := self.Inner.Min.X
+	for i, menu := range self.Menus {
+		title, _ := parseAccelerator(menu.Title)
+		width := rw.StringWidth(title) + 2
+		rects[i] = image.Rect(x, self.Inner.Min.Y, MinInt(x+width, self.Inner.Max.X), self.Inner.Min.Y+1)
+		x += width
+	}
+	return rects
+}
+
+func (self *MenuBar) Draw(buf *Buffer) {
+	self.Block.Draw(buf)
+
+	buf.Fill(NewCell(' ', self.TextStyle), image.Rect(self.Inner.Min.X, self.Inner.Min.Y, self.Inner.Max.X, self.Inner.Min.Y+1))
+	for i, rect := range self.titleRects() {
+		if rect.Empty() {
+			break
+		}
+		style := self.TextStyle
+		if i == self.active {
+			style = self.SelectedStyle
+		}
+		title, accelerator := parseAccelerator(self.Menus[i].Title)
+		buf.Fill(NewCell(' ', style), rect)
+		trimmed := TrimString(title, rect.Dx()-1)
+		buf.SetString(trimmed, style, rect.Min.Add(image.Pt(1, 0)))
+		if runes := []rune(trimmed); accelerator >= 0 && trimmed == title {
+			style.Modifier |= ModifierUnderline
+			buf.SetCell(
+				NewCell(runes[accelerator], style),
+				rect.Min.Add(image.Pt(1+rw.StringWidth(string(runes[:accelerator])), 0)),
+			)
+		}
+	}
+}
+
+// newMenu returns a ContextMenu for items opened at p.
+func (self *MenuBar) newMenu(items []MenuItem, p image.Point) *ContextMenu {
+	menu := NewContextMenu()
+	menu.Items = items
+	menu.Accelerators = true
+	menu.OpenWithin(p, self.Bounds)
+	return menu
+}
+
+// OpenMenu opens the menu at index, closing any other.
+func (self *MenuBar) OpenMenu(index int) {
+	if index < 0 || index >= len(self.Menus) {
+		return
+	}
+	rect := self.titleRects()[index]
+	self.active = index
+	self.open = []*ContextMenu{self.newMenu(self.Menus[index].Items, image.Pt(rect.Min.X, rect.Max.Y))}
+}
+
+// Close closes all menus.
+func (self *MenuBar) Close() {
+	self.open = nil
+	self.active = -1
+}
+
+// closeSubmenu closes the deepest submenu, or all menus if no submenu is open.
+func (self *MenuBar) closeSubmenu() {
+	if len(self.open) > 1 {
+		self.open = self.open[:len(self.open)-1]
+	} else {
+		self.Close()
+	}
+}
+
+// openSubmenu opens the submenu of the selected item of the deepest menu, if it has one.
+func (self *MenuBar) openSubmenu() bool {
+	submenu := openSubmenuOf(self.open[len(self.open)-1], self.Bounds)
+	if submenu == nil {
+		return false
+	}
+	self.open = append(self.open, submenu)
+	return true
+}
+
+// activate chooses the selected item of the deepest menu, opening its submenu or calling
+// its Action after closing all menus.
+func (self *MenuBar) activate() {
+	item := self.open[len(self.open)-1].SelectedItem()
+	if item == nil || self.openSubmenu() {
+		return
+	}
+	self.Close()
+	if item.Action != nil {
+		item.Action(nil)
+	}
+}
+
+// titleAccelerator returns the index of the menu whose title accelerator is key, or -1.
+func (self *MenuBar) titleAccelerator(key rune) int {
+	for i, menu := range self.Menus {
+		title, accelerator := parseAccelerator(menu.Title)
+		if accelerator >= 0 && unicode.ToLower([]rune(title)[accelerator]) == unicode.ToLower(key) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ActivateShortcut calls the Action of the first enabled item, including those in
+// submenus, whose Shortcut is id and returns whether there is one.
+func (self *MenuBar) ActivateShortcut(id string) bool {
+	var find func(items []MenuItem) *MenuItem
+	find = func(items []MenuItem) *MenuItem {
+		for i, item := range items {
+			if item.Shortcut == id && item.enabled() && len(item.Items) == 0 {
+				return &items[i]
+			}
+			if found := find(item.Items); found != nil {
+				return found
+			}
+		}
+		return nil
+	}
+	for _, menu := range self.Menus {
+		if item := find(menu.Items); item != nil {
+			self.Close()
+			if item.Action != nil {
+				item.Action(nil)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// HandleEvent opens menus with OpenKey, Alt with a title accelerator, e.g. <M-f>, and
+// clicks on titles, and calls the Action of items whose Shortcut is pressed. While a menu
+// is open, it uses all keyboard events: the arrow keys navigate the menus and submenus,
+// <Enter> chooses an item, typing an accelerator chooses its item and <Escape> closes
+// the deepest menu. Clicks outside of the menus close them.
+// It returns true if the event was used.
+func (self *MenuBar) HandleEvent(e Event) bool {
+	if e.Type == MouseEvent {
+		return self.handleMouseEvent(e)
+	}
+	if e.Type != KeyboardEvent || e.ID == "" {
+		return false
+	}
+	if len(e.ID) > 4 && e.ID[:3] == "<M-" {
+		if r := []rune(e.ID[3 : len(e.ID)-1]); len(r) == 1 {
+			if index := self.titleAccelerator(r[0]); index >= 0 {
+				self.OpenMenu(index)
+				return true
+			}
+		}
+	}
+	if !self.IsOpen() {
+		if e.ID == self.OpenKey {
+			self.OpenMenu(0)
+			return true
+		}
+		return self.ActivateShortcut(e.ID)
+	}
+
+	menu := self.open[len(self.open)-1]
+	switch e.ID {
+	case self.OpenKey:
+		self.Close()
+	case "<Up>":
+		menu.ScrollUp()
+	case "<Down>":
+		menu.ScrollDown()
+	case "<Left>":
+		if len(self.open) > 1 {
+			self.closeSubmenu()
+		} else if len(self.Menus) > 0 {
+			self.OpenMenu((self.active - 1 + len(self.Menus)) % len(self.Menus))
+		}
+	case "<Right>":
+		if !self.openSubmenu() && len(self.Menus) > 0 {
+			self.OpenMenu((self.active + 1) % len(self.Menus))
+		}
+	case "<Enter>", "<Space>":
+		self.activate()
+	case "<Escape>":
+		self.closeSubmenu()
+	default:
+		if r := []rune(e.ID); len(r) == 1 {
+			if row := menu.AcceleratorRow(r[0]); row >= 0 {
+				menu.SelectedRow = row
+				self.activate()
+			}
+		}
+	}
+	return true
+}
+
+// handleMouseEvent opens and closes menus by their titles and chooses clicked items.
+func (self *MenuBar) handleMouseEvent(e Event) bool {
+	mouse, ok := e.Payload.(Mouse)
+	if !ok {
+		return false
+	}
+	if e.ID == "<MouseRelease>" {
+		return self.IsOpen()
+	}
+	if e.ID != "<MouseLeft>" || mouse.Drag {
+		return false
+	}
+	point := image.Pt(mouse.X, mouse.Y)
+	for i, rect := range self.titleRects() {
+		if point.In(rect) {
+			if i == self.active {
+				self.Close()
+			} else {
+				self.OpenMenu(i)
+			}
+			return true
+		}
+	}
+	if !self.IsOpen() {
+		return false
+	}
+	level := menuLevelAt(self.open, point)
+	if level < 0 {
+		self.Close()
+		return true
+	}
+	menu := self.open[level]
+	if row := point.Y - menu.Inner.Min.Y; row < len(menu.Items) && menu.Items[row].enabled() {
+		self.open = self.open[:level+1]
+		menu.SelectedRow = row
+		self.activate()
+	}
+	return true
+}
@@ -15,34 +15,187 @@ type Paragraph struct {
 	Text      string
 	TextStyle Style
 	WrapText  bool
+
+	// WrapMode is where lines are broken if WrapText is set. Hyphenate adds a hyphen to
+	// words that are broken up.
+	WrapMode  WrapMode
+	Hyphenate bool
+
+	// TextAlignment aligns every line within the width of the paragraph. AlignJustify
+	// applies to the wrapped lines of each block of text that ends with a line break.
+	TextAlignment Alignment
+
+	// Markdown parses the Text as markdown instead of the embedded style syntax, see
+	// ParseMarkdown. The markdown elements are drawn in MarkdownStyles.
+	Markdown       bool
+	MarkdownStyles MarkdownStyles
+
+	// ANSI interprets embedded SGR escape sequences instead of the embedded style syntax,
+	// see ParseANSI. It is ignored if Markdown is set.
+	ANSI bool
+
+	// ScrollOffset is the index of the first line shown, see ScrollDown.
+	ScrollOffset int
+	// ColumnOffset is the index of the first cell shown of every line if WrapText is false,
+	// see ScrollRight.
+	ColumnOffset int
+	// Scrollbar is drawn at the right edge if set, e.g. with NewScrollbar().
+	Scrollbar *Scrollbar
+
+	// SearchText highlights its matches, ignoring case, with SearchStyle and the
+	// current match with CurrentMatchStyle, see Search and NextMatch.
+	SearchText        string
+	SearchStyle       Style
+	CurrentMatchStyle Style
+	currentMatch      int
 }
 
 func NewParagraph() *Paragraph {
 	return &Paragraph{
-		Block:     *NewBlock(),
-		TextStyle: Theme.Paragraph.Text,
-		WrapText:  true,
+		Block:             *NewBlock(),
+		TextStyle:         Theme.Paragraph.Text,
+		WrapText:          true,
+		MarkdownStyles:    Theme.Paragraph.Markdown,
+		SearchStyle:       Theme.Paragraph.Match,
+		CurrentMatchStyle: Theme.Paragraph.CurrentMatch,
 	}
 }
 
-func (self *Paragraph) Draw(buf *Buffer) {
-	self.Block.Draw(buf)
+// contentWidth returns the width of the text, which leaves room for the Scrollbar.
+func (self *Paragraph) contentWidth() int {
+	if self.Scrollbar != nil {
+		return self.Inner.Dx() - 1
+	}
+	return self.Inner.Dx()
+}
+
+// lines returns the lines of the Text as they are drawn.
+func (self *Paragraph) lines() [][]Cell {
+	var cells []Cell
+	switch {
+	case self.Markdown:
+		cells = ParseMarkdown(self.Text, self.TextStyle, self.MarkdownStyles)
+	case self.ANSI:
+		cells = ParseANSI(self.Text, self.TextStyle)
+	default:
+		cells = ParseStyles(self.Text, self.TextStyle)
+	}
+	lines := [][]Cell{}
+	for _, block := range SplitCells(cells, '\n') {
+		wrapped := [][]Cell{block}
+		if self.WrapText {
+			wrapped = SplitCells(WrapCellsWith(block, self.contentWidth(), self.WrapMode, self.Hyphenate), '\n')
+		}
+		if len(wrapped) == 0 {
+			// keep empty lines
+			wrapped = [][]Cell{{}}
+		}
+		for i, line := range wrapped {
+			lines = append(lines, self.alignLine(line, i == len(wrapped)-1))
+		}
+	}
+	return lines
+}
 
-	cells := ParseStyles(self.Text, self.TextStyle)
-	if self.WrapText {
-		cells = WrapCells(cells, uint(self.Inner.Dx()))
+// links returns the hyperlinks of the Text. Markdown has none.
+func (self *Paragraph) links() []Link {
+	switch {
+	case self.Markdown:
+		return nil
+	case self.ANSI:
+		return ParseANSILinks(self.Text)
 	}
+	return ParseStyleLinks(self.Text)
+}
+
+// clampScrollOffset keeps the ScrollOffset between the first line and the last page.
+func (self *Paragraph) clampScrollOffset(lines int) {
+	self.ScrollOffset = MaxInt(MinInt(self.ScrollOffset, lines-self.Inner.Dy()), 0)
+}
+
+// clampColumnOffset keeps the ColumnOffset between the first cell and the end of the longest line.
+func (self *Paragraph) clampColumnOffset(lines [][]Cell) {
+	longest := 0
+	for _, line := range lines {
+		longest = MaxInt(longest, len(line))
+	}
+	self.ColumnOffset = MaxInt(MinInt(self.ColumnOffset, longest-self.contentWidth()), 0)
+}
+
+func (self *Paragraph) Draw(buf *Buffer) {
+	self.Block.Draw(buf)
 
-	rows := SplitCells(cells, '\n')
+	rows := self.highlightMatches(self.lines())
+	self.clampScrollOffset(len(rows))
+	self.clampColumnOffset(rows)
 
-	for y, row := range rows {
+	for y, row := range rows[self.ScrollOffset:] {
 		if y+self.Inner.Min.Y >= self.Inner.Max.Y {
 			break
 		}
-		row = TrimCells(row, self.Inner.Dx())
+		if !self.WrapText {
+			row = row[MinInt(self.ColumnOffset, len(row)):]
+		}
+		row = TrimCells(row, self.contentWidth())
 		for _, cx := range BuildCellWithXArray(row) {
 			x, cell := cx.X, cx.Cell
 			buf.SetCell(cell, image.Pt(x, y).Add(self.Inner.Min))
 		}
 	}
+
+	buf.SetLinks(self.links(), self.Inner)
+
+	if self.Scrollbar != nil {
+		self.Scrollbar.Draw(buf, self.Inner.Max.X-1, self.Inner.Min.Y, self.Inner.Max.Y, len(rows), self.ScrollOffset, self.Inner.Dy())
+	}
+}
+
+// ScrollAmount scrolls by amount lines. If amount is < 0, then scroll up.
+func (self *Paragraph) ScrollAmount(amount int) {
+	self.ScrollOffset += amount
+	self.clampScrollOffset(len(self.lines()))
+}
+
+func (self *Paragraph) ScrollUp() {
+	self.ScrollAmount(-1)
+}
+
+func (self *Paragraph) ScrollDown() {
+	self.ScrollAmount(1)
+}
+
+func (self *Paragraph) ScrollPageUp() {
+	self.ScrollAmount(-self.Inner.Dy())
+}
+
+func (self *Paragraph) ScrollPageDown() {
+	self.ScrollAmount(self.Inner.Dy())
+}
+
+func (self *Paragraph) ScrollHalfPageUp() {
+	self.ScrollAmount(-int(FloorFloat64(float64(self.Inner.Dy()) / 2)))
+}
+
+func (self *Paragraph) ScrollHalfPageDown() {
+	self.ScrollAmount(int(FloorFloat64(float64(self.Inner.Dy()) / 2)))
+}
+
+func (self *Paragraph) ScrollTop() {
+	self.ScrollOffset = 0
+}
+
+func (self *Paragraph) ScrollBottom() {
+	self.ScrollOffset = len(self.lines())
+	self.clampScrollOffset(self.ScrollOffset)
+}
+
+// ScrollLeft scrolls the lines one cell to the left if WrapText is false.
+func (self *Paragraph) ScrollLeft() {
+	self.ColumnOffset = MaxInt(self.ColumnOffset-1, 0)
+}
+
+// ScrollRight scrolls the lines one cell to the right if WrapText is false.
+func (self *Paragraph) ScrollRight() {
+	self.ColumnOffset++
+	self.clampColumnOffset(self.lines())
 }
@@ -0,0 +1,74 @@
+package widgets
+
+import (
+	"strings"
+
+	rw "github.com/mattn/go-runewidth"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// alignLine aligns a line according to the TextAlignment. last is set for the last line
+// of a block, which isn't justified.
+func (self *Paragraph) alignLine(line []Cell, last bool) []Cell {
+	width := self.contentWidth()
+	lineWidth := rw.StringWidth(CellsToString(line))
+	if lineWidth >= width {
+		return line
+	}
+	switch self.TextAlignment {
+	case AlignCenter:
+		return append(self.padding((width-lineWidth)/2), line...)
+	case AlignRight:
+		return append(self.padding(width-lineWidth), line...)
+	case AlignJustify:
+		if !last {
+			return self.justifyLine(line, width)
+		}
+	}
+	return line
+}
+
+// padding returns count blank cells in the TextStyle.
+func (self *Paragraph) padding(count int) []Cell {
+	return RunesToStyledCells([]rune(strings.Repeat(" ", count)), self.TextStyle)
+}
+
+// justifyLine widens the gaps between the words of a line, so that it fills width.
+// The extra space is spread evenly, with the leftmost gaps receiving the remainder.
+func (self *Paragraph) justifyLine(line []Cell, width int) []Cell {
+	// leading spaces are kept as indentation, trailing spaces are dropped
+	end := len(line)
+	for end > 0 && line[end-1].Rune == ' ' {
+		end--
+	}
+	start := 0
+	for start < end && line[start].Rune == ' ' {
+		start++
+	}
+	gaps := 0
+	for i := start + 1; i < end; i++ {
+		if line[i].Rune == ' ' && line[i-1].Rune != ' ' {
+			gaps++
+		}
+	}
+	if gaps == 0 {
+		return line
+	}
+
+	extra := width - rw.StringWidth(CellsToString(line[:end]))
+	justified := append([]Cell{}, line[:start]...)
+	gap := 0
+	for i := start; i < end; i++ {
+		justified = append(justified, line[i])
+		if line[i].Rune == ' ' && line[i-1].Rune != ' ' {
+			count := extra / gaps
+			if gap < extra%gaps {
+				count++
+			}
+			justified = append(justified, RunesToStyledCells([]rune(strings.Repeat(" ", count)), line[i].Style)...)
+			gap++
+		}
+	}
+	return justified
+}
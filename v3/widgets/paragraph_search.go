@@ -0,0 +1,104 @@
+package widgets
+
+import (
+	. "github.com/s-westphal/termui/v3"
+)
+
+// paragraphMatch is the position of a match of the SearchText in the drawn lines.
+type paragraphMatch struct {
+	line   int
+	column int
+}
+
+// matches returns the matches of the SearchText in lines. Matches that are broken
+// across wrapped lines aren't found.
+func (self *Paragraph) matches(lines [][]Cell) []paragraphMatch {
+	matches := []paragraphMatch{}
+	if self.SearchText == "" {
+		return matches
+	}
+	for i, line := range lines {
+		for _, column := range findMatches(line, self.SearchText) {
+			matches = append(matches, paragraphMatch{i, column})
+		}
+	}
+	return matches
+}
+
+// highlightMatches applies the SearchStyle to the matches in lines and the
+// CurrentMatchStyle to the current match.
+func (self *Paragraph) highlightMatches(lines [][]Cell) [][]Cell {
+	length := len([]rune(self.SearchText))
+	for i, match := range self.matches(lines) {
+		style := self.SearchStyle
+		if i == self.currentMatch {
+			style = self.CurrentMatchStyle
+		}
+		for j := match.column; j < match.column+length; j++ {
+			lines[match.line][j].Style = style
+		}
+	}
+	return lines
+}
+
+// Search highlights the matches of query, ignoring case, scrolls to the first one
+// and returns the number of matches.
+func (self *Paragraph) Search(query string) int {
+	self.SearchText = query
+	self.currentMatch = 0
+	self.scrollToMatch()
+	return self.MatchCount()
+}
+
+// MatchCount returns the number of matches of the SearchText.
+func (self *Paragraph) MatchCount() int {
+	return len(self.matches(self.lines()))
+}
+
+// CurrentMatch returns the index of the current match, which is highlighted with
+// the CurrentMatchStyle.
+func (self *Paragraph) CurrentMatch() int {
+	return self.currentMatch
+}
+
+// NextMatch makes the next match the current one and scrolls to it,
+// wrapping around after the last match.
+func (self *Paragraph) NextMatch() {
+	if count := self.MatchCount(); count > 0 {
+		self.currentMatch = (self.currentMatch + 1) % count
+		self.scrollToMatch()
+	}
+}
+
+// PrevMatch makes the previous match the current one and scrolls to it,
+// wrapping around before the first match.
+func (self *Paragraph) PrevMatch() {
+	if count := self.MatchCount(); count > 0 {
+		self.currentMatch = (self.currentMatch - 1 + count) % count
+		self.scrollToMatch()
+	}
+}
+
+// scrollToMatch centers the current match vertically if it isn't visible and,
+// if WrapText is false, horizontally.
+func (self *Paragraph) scrollToMatch() {
+	lines := self.lines()
+	matches := self.matches(lines)
+	if len(matches) == 0 {
+		return
+	}
+	self.currentMatch = MinInt(self.currentMatch, len(matches)-1)
+	match := matches[self.currentMatch]
+	if match.line < self.ScrollOffset || match.line >= self.ScrollOffset+self.Inner.Dy() {
+		self.ScrollOffset = match.line - self.Inner.Dy()/2
+		self.clampScrollOffset(len(lines))
+	}
+	if self.WrapText {
+		return
+	}
+	end := match.column + len([]rune(self.SearchText))
+	if match.column < self.ColumnOffset || end > self.ColumnOffset+self.contentWidth() {
+		self.ColumnOffset = match.column - self.contentWidth()/2
+		self.clampColumnOffset(lines)
+	}
+}
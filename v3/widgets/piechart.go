@@ -1,6 +1,7 @@
 package widgets
 
 import (
+	"fmt"
 	"image"
 	"math"
 
@@ -23,14 +24,40 @@ type PieChart struct {
 	Colors         []Color       // colors to by cycled through
 	LabelFormatter PieChartLabel // callback function for labels
 	AngleOffset    float64       // which angle to start drawing at? (see piechartOffsetUp)
+
+	// InnerRadius turns the pie into a donut with a hole of the given fraction of the radius.
+	// The hole shows the Caption in CaptionStyle, or the sum of the Data formatted with
+	// TotalFormatter if the Caption is empty. Lines of the Caption are separated by newlines.
+	InnerRadius    float64
+	Caption        string
+	CaptionStyle   Style
+	TotalFormatter func(float64) string
+
+	// ExternalLabels places the labels next to the pie, connected to their slices by leader
+	// lines, which keeps the labels of small slices readable. The pie shrinks to make room.
+	ExternalLabels bool
+
+	// Selectable highlights the SelectedSlice, moves it ExplodeDistance outwards and makes its
+	// label bold, see SelectNext and HandleEvent. OnSelect is called when the selection changes.
+	Selectable      bool
+	SelectedSlice   int
+	ExplodeDistance float64
+	OnSelect        func(index int)
+
+	// center and radius are the geometry of the last Draw.
+	center image.Point
+	radius float64
 }
 
 // NewPieChart Creates a new pie chart with reasonable defaults and no labels.
 func NewPieChart() *PieChart {
 	return &PieChart{
-		Block:       *NewBlock(),
-		Colors:      Theme.PieChart.Slices,
-		AngleOffset: piechartOffsetUp,
+		Block:           *NewBlock(),
+		Colors:          Theme.PieChart.Slices,
+		AngleOffset:     piechartOffsetUp,
+		CaptionStyle:    Theme.PieChart.Caption,
+		ExplodeDistance: 1,
+		TotalFormatter:  func(n float64) string { return fmt.Sprint(n) },
 	}
 }
 
@@ -39,6 +66,13 @@ func (self *PieChart) Draw(buf *Buffer) {
 
 	center := self.Inner.Min.Add(self.Inner.Size().Div(2))
 	radius := MinFloat64(float64(self.Inner.Dx()/2/xStretch), float64(self.Inner.Dy()/2))
+	if self.ExternalLabels && self.LabelFormatter != nil {
+		radius = self.externalLabelRadius()
+	}
+	if self.Selectable {
+		// leave room for the exploded slice
+		radius = MaxFloat64(radius-self.ExplodeDistance, 1)
+	}
 
 	// compute slice sizes
 	sum := SumFloat64Slice(self.Data)
@@ -48,31 +82,43 @@ func (self *PieChart) Draw(buf *Buffer) {
 	}
 
 	borderCircle := &circle{center, radius}
-	middleCircle := circle{Point: center, radius: radius / 2.0}
+	middleCircle := circle{Point: center, radius: radius * (1 + self.innerRadius()) / 2.0}
 
-	// draw sectors
+	self.center, self.radius = center, radius
+
+	// draw sectors, the selected one last since it is moved outwards
 	phi := self.AngleOffset
+	selectedPhi := phi
 	for i, size := range sliceSizes {
-		for j := 0.0; j < size; j += resolutionFactor {
-			borderPoint := borderCircle.at(phi + j)
-			line := line{P1: center, P2: borderPoint}
-			line.draw(NewCell(SHADED_BLOCKS[1], NewStyle(SelectColor(self.Colors, i))), buf)
+		if self.isSelected(i) {
+			selectedPhi = phi
+		} else {
+			self.drawSlice(buf, i, borderCircle, phi, size)
 		}
 		phi += size
 	}
+	if self.isSelected(self.SelectedSlice) {
+		self.drawSlice(buf, self.SelectedSlice, borderCircle, selectedPhi, sliceSizes[self.SelectedSlice])
+	}
+
+	if self.innerRadius() > 0 {
+		self.drawHole(buf, center, radius*self.innerRadius(), sum)
+	}
 
 	// draw labels
-	if self.LabelFormatter != nil {
+	if self.ExternalLabels && self.LabelFormatter != nil {
+		self.drawExternalLabels(buf, center, radius, sliceSizes)
+	} else if self.LabelFormatter != nil {
 		phi = self.AngleOffset
 		for i, size := range sliceSizes {
 			labelPoint := middleCircle.at(phi + size/2.0)
-			if len(self.Data) == 1 {
+			if len(self.Data) == 1 && self.innerRadius() == 0 {
 				labelPoint = center
 			}
 			buf.SetString(
 				self.LabelFormatter(i, self.Data[i]),
-				NewStyle(SelectColor(self.Colors, i)),
-				image.Pt(labelPoint.X, labelPoint.Y),
+				self.labelStyle(i),
+				labelPoint.Add(self.explodeOffset(i, phi+size/2.0)),
 			)
 			phi += size
 		}
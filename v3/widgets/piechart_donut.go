@@ -0,0 +1,49 @@
+package widgets
+
+import (
+	"image"
+	"math"
+	"strings"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// innerRadius returns the InnerRadius clamped to [0, 0.95], so that a ring remains.
+func (self *PieChart) innerRadius() float64 {
+	return MaxFloat64(MinFloat64(self.InnerRadius, 0.95), 0)
+}
+
+// drawHole clears the cells within radius of the center and draws the Caption, or the
+// total if it is empty, centered in the hole.
+func (self *PieChart) drawHole(buf *Buffer, center image.Point, radius float64, total float64) {
+	for y := self.Inner.Min.Y; y < self.Inner.Max.Y; y++ {
+		for x := self.Inner.Min.X; x < self.Inner.Max.X; x++ {
+			dx := float64(x-center.X) / xStretch
+			dy := float64(y - center.Y)
+			if math.Sqrt(dx*dx+dy*dy) < radius {
+				buf.SetCell(NewCell(' '), image.Pt(x, y))
+			}
+		}
+	}
+
+	caption := self.Caption
+	if caption == "" && self.TotalFormatter != nil {
+		caption = self.TotalFormatter(total)
+	}
+	if caption == "" {
+		return
+	}
+	lines := strings.Split(caption, "\n")
+	// the lines have to fit into the hole, which is narrower above and below the center
+	for i, text := range lines {
+		y := center.Y - len(lines)/2 + i
+		dy := float64(y - center.Y)
+		if math.Abs(dy) >= radius {
+			continue
+		}
+		width := int(2 * xStretch * math.Sqrt(radius*radius-dy*dy))
+		text = TrimString(text, MaxInt(width, 1))
+		buf.SetString(text, self.CaptionStyle, image.Pt(center.X-rw.StringWidth(text)/2, y))
+	}
+}
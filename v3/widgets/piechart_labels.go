@@ -0,0 +1,128 @@
+package widgets
+
+import (
+	"image"
+	"math"
+	"sort"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// pieLabel is a label placed next to the pie.
+type pieLabel struct {
+	index  int
+	text   string
+	anchor image.Point
+	y      int
+}
+
+// externalLabelRadius returns the radius of the pie that leaves room for the labels and
+// their leader lines on both sides, and a row above and below for the leader lines.
+func (self *PieChart) externalLabelRadius() float64 {
+	labelWidth := 0
+	for i, v := range self.Data {
+		labelWidth = MaxInt(labelWidth, rw.StringWidth(self.LabelFormatter(i, v)))
+	}
+	height := self.Inner.Dy()
+	return MaxFloat64(MinFloat64(
+		float64(self.Inner.Dx()/2-labelWidth-5)/xStretch,
+		float64(MinInt(height/2-1, height-height/2-2)),
+	), 1)
+}
+
+// drawExternalLabels draws the labels on the side of the pie their slice is on, moving
+// them apart vertically if they overlap, and connects them to their slices.
+func (self *PieChart) drawExternalLabels(buf *Buffer, center image.Point, radius float64, sliceSizes []float64) {
+	anchorCircle := circle{Point: center, radius: radius + 1}
+	var left, right []*pieLabel
+	phi := self.AngleOffset
+	for i, size := range sliceSizes {
+		middle := phi + size/2
+		label := &pieLabel{
+			index:  i,
+			text:   self.LabelFormatter(i, self.Data[i]),
+			anchor: anchorCircle.at(middle),
+		}
+		label.y = label.anchor.Y
+		if math.Cos(middle) >= 0 {
+			right = append(right, label)
+		} else {
+			left = append(left, label)
+		}
+		phi += size
+	}
+
+	edge := int(RoundFloat64(xStretch * radius))
+	for _, side := range []struct {
+		labels    []*pieLabel
+		direction int
+	}{{right, 1}, {left, -1}} {
+		self.spreadLabels(side.labels)
+		for _, label := range side.labels {
+			style := self.labelStyle(label.index)
+			// the column of the vertical part of the leader line
+			end := center.X + side.direction*(edge+2)
+			self.drawLeaderLine(buf, label, end, side.direction, style)
+			x := end + 3
+			if side.direction < 0 {
+				x = end - 2 - rw.StringWidth(label.text)
+			}
+			buf.SetString(label.text, style, image.Pt(x, label.y))
+		}
+	}
+}
+
+// spreadLabels moves the labels of one side apart so that every label gets its own row
+// within the Inner area, keeping them as close to their anchors as possible.
+func (self *PieChart) spreadLabels(labels []*pieLabel) {
+	sort.SliceStable(labels, func(i, j int) bool {
+		return labels[i].y < labels[j].y
+	})
+	top, bottom := self.Inner.Min.Y, self.Inner.Max.Y-1
+	for i, label := range labels {
+		label.y = MaxInt(label.y, top)
+		if i > 0 {
+			label.y = MaxInt(label.y, labels[i-1].y+1)
+		}
+	}
+	for i := len(labels) - 1; i >= 0; i-- {
+		label := labels[i]
+		label.y = MinInt(label.y, bottom)
+		if i < len(labels)-1 {
+			label.y = MinInt(label.y, labels[i+1].y-1)
+		}
+	}
+}
+
+// drawLeaderLine connects the anchor of a label to the row of the label: horizontally away
+// from the pie to the column end, vertically to the row of the label and outwards again,
+// so that the line doesn't cross the pie.
+func (self *PieChart) drawLeaderLine(buf *Buffer, label *pieLabel, end, direction int, style Style) {
+	anchor := label.anchor
+	for x := anchor.X; x*direction < end*direction; x += direction {
+		buf.SetCell(NewCell(HORIZONTAL_LINE, style), image.Pt(x, anchor.Y))
+	}
+	step := 1
+	if label.y < anchor.Y {
+		step = -1
+	}
+	for y := anchor.Y + step; y*step < label.y*step; y += step {
+		buf.SetCell(NewCell(VERTICAL_LINE, style), image.Pt(end, y))
+	}
+
+	// the corners are indexed by whether the line goes down and whether it goes right
+	turn, arrive := HORIZONTAL_LINE, HORIZONTAL_LINE
+	if label.y != anchor.Y {
+		corners := map[[2]bool][2]rune{
+			{true, true}:   {'╮', '╰'},
+			{false, true}:  {'╯', '╭'},
+			{true, false}:  {'╭', '╯'},
+			{false, false}: {'╰', '╮'},
+		}[[2]bool{step > 0, direction > 0}]
+		turn, arrive = corners[0], corners[1]
+	}
+	buf.SetCell(NewCell(turn, style), image.Pt(end, anchor.Y))
+	buf.SetCell(NewCell(arrive, style), image.Pt(end, label.y))
+	buf.SetCell(NewCell(HORIZONTAL_LINE, style), image.Pt(end+direction, label.y))
+}
@@ -0,0 +1,116 @@
+package widgets
+
+import (
+	"image"
+	"math"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// isSelected returns whether slice i is the selected slice of a Selectable PieChart.
+func (self *PieChart) isSelected(i int) bool {
+	return self.Selectable && i == self.SelectedSlice && i >= 0 && i < len(self.Data)
+}
+
+// labelStyle returns the style of the label of slice i, which is bold if it is selected.
+func (self *PieChart) labelStyle(i int) Style {
+	style := NewStyle(SelectColor(self.Colors, i))
+	if self.isSelected(i) {
+		style.Modifier |= ModifierBold
+	}
+	return style
+}
+
+// explodeOffset returns how far slice i is moved outwards along the angle phi of its middle.
+func (self *PieChart) explodeOffset(i int, phi float64) image.Point {
+	if !self.isSelected(i) {
+		return image.Point{}
+	}
+	return circle{radius: self.ExplodeDistance}.at(phi)
+}
+
+// drawSlice fills the slice i from the angle phi with the given size. The selected slice
+// is moved outwards and drawn with denser shading.
+func (self *PieChart) drawSlice(buf *Buffer, i int, border *circle, phi, size float64) {
+	offset := self.explodeOffset(i, phi+size/2)
+	shade := SHADED_BLOCKS[1]
+	if self.isSelected(i) {
+		shade = SHADED_BLOCKS[3]
+	}
+	cell := NewCell(shade, NewStyle(SelectColor(self.Colors, i)))
+	for j := 0.0; j < size; j += resolutionFactor {
+		borderPoint := border.at(phi + j)
+		line := line{P1: border.Point.Add(offset), P2: borderPoint.Add(offset)}
+		line.draw(cell, buf)
+	}
+}
+
+// Select selects slice index and calls OnSelect if the selection changed.
+func (self *PieChart) Select(index int) {
+	if !self.Selectable || len(self.Data) == 0 {
+		return
+	}
+	index = MaxInt(MinInt(index, len(self.Data)-1), 0)
+	if index == self.SelectedSlice {
+		return
+	}
+	self.SelectedSlice = index
+	if self.OnSelect != nil {
+		self.OnSelect(index)
+	}
+}
+
+// SelectNext selects the next slice clockwise, wrapping around.
+func (self *PieChart) SelectNext() {
+	if len(self.Data) > 0 {
+		self.Select((self.SelectedSlice + 1) % len(self.Data))
+	}
+}
+
+// SelectPrevious selects the next slice counterclockwise, wrapping around.
+func (self *PieChart) SelectPrevious() {
+	if len(self.Data) > 0 {
+		self.Select((self.SelectedSlice - 1 + len(self.Data)) % len(self.Data))
+	}
+}
+
+// SliceAt returns the index of the slice drawn at the given point by the last Draw,
+// or -1 if there is none.
+func (self *PieChart) SliceAt(p image.Point) int {
+	dx := float64(p.X-self.center.X) / xStretch
+	dy := float64(p.Y - self.center.Y)
+	if self.radius == 0 || math.Sqrt(dx*dx+dy*dy) > self.radius+0.5 {
+		return -1
+	}
+	sum := SumFloat64Slice(self.Data)
+	if sum == 0 {
+		return -1
+	}
+	// the angle of the point clockwise from the start of the first slice
+	phi := math.Mod(math.Atan2(dy, dx)-self.AngleOffset, fullCircle)
+	if phi < 0 {
+		phi += fullCircle
+	}
+	for i, v := range self.Data {
+		phi -= v / sum * fullCircle
+		if phi < 0 {
+			return i
+		}
+	}
+	return len(self.Data) - 1
+}
+
+// HandleEvent selects the slice clicked with the left mouse button and returns whether
+// the event was used.
+func (self *PieChart) HandleEvent(e Event) bool {
+	mouse, ok := e.Payload.(Mouse)
+	if e.Type != MouseEvent || !ok || e.ID != "<MouseLeft>" || !self.Selectable {
+		return false
+	}
+	index := self.SliceAt(image.Pt(mouse.X, mouse.Y))
+	if index < 0 {
+		return false
+	}
+	self.Select(index)
+	return true
+}
@@ -35,6 +35,34 @@ type Plot struct {
 	PlotType        PlotType
 	HorizontalScale int
 	DrawDirection   DrawDirection // TODO
+
+	// LineThickness and AntiAlias are passed to the Canvas of braille line charts.
+	LineThickness int
+	AntiAlias     bool
+
+	// NumFormatter formats the numeric axis labels, e.g. AbbreviateNumber.
+	NumFormatter func(float64) string
+
+	// XOffset is the index of the first data point shown by line charts.
+	XOffset int
+
+	// Measure shows two vertical Cursors, given as data point index (or x value for scatter plots),
+	// together with the x distance and the per line y delta between them.
+	Measure      bool
+	Cursors      [2]float64
+	ActiveCursor int
+	CursorStyle  Style
+	// MeasureFormatter formats the x distance between the cursors. Defaults to NumFormatter.
+	MeasureFormatter func(float64) string
+
+	// Categories switches line charts to a categorical x axis: Categories[i] names the
+	// category of data point i, and consecutive points of the same category form one
+	// section with a tick at its start and the category name as label. Long names are
+	// truncated to the section width, or written top to bottom if RotateCategoryLabels is set.
+	Categories           []string
+	RotateCategoryLabels bool
+
+	linkGroup *LinkGroup
 }
 
 const (
@@ -74,6 +102,7 @@ func NewPlot() *Plot {
 		DotMarkerRune:   DOT,
 		Data:            [][]float64{},
 		HorizontalScale: 1,
+		LineThickness:   1,
 		DrawDirection:   DrawRight,
 		ShowAxes:        true,
 		PlotType:        LineChart,
@@ -81,12 +110,16 @@ func NewPlot() *Plot {
 		MaxVal:          math.Inf(-1),
 		XMinVal:         math.Inf(1),
 		XMaxVal:         math.Inf(-1),
+		NumFormatter:    func(n float64) string { return fmt.Sprintf("%.2f", n) },
+		CursorStyle:     Theme.Plot.Cursor,
 	}
 }
 
 func (self *Plot) renderBraille(buf *Buffer, drawArea image.Rectangle, minVal float64, maxVal float64) {
 	canvas := NewCanvas()
 	canvas.Rectangle = drawArea
+	canvas.LineThickness = self.LineThickness
+	canvas.AntiAlias = self.AntiAlias
 	xDx := MaxFloat64(1, self.XMaxVal-self.XMinVal)
 
 	switch self.PlotType {
@@ -105,6 +138,10 @@ func (self *Plot) renderBraille(buf *Buffer, drawArea image.Rectangle, minVal fl
 		}
 	case LineChart:
 		for i, line := range self.Data {
+			line = line[MinInt(self.XOffset, len(line)):]
+			if len(line) < 2 {
+				continue
+			}
 			previousHeight := int(((line[1] - minVal) / MaxFloat64(1, maxVal-minVal)) * float64(drawArea.Dy()-1))
 			for j, val := range line[1:] {
 				height := int((val - minVal) / MaxFloat64(1, maxVal-minVal) * float64(drawArea.Dy()-1))
@@ -145,6 +182,7 @@ func (self *Plot) renderDot(buf *Buffer, drawArea image.Rectangle, minVal float6
 		}
 	case LineChart:
 		for i, line := range self.Data {
+			line = line[MinInt(self.XOffset, len(line)):]
 			for j := 0; j < len(line) && j*self.HorizontalScale < drawArea.Dx(); j++ {
 				val := line[j]
 				height := int((val - minVal) / MaxFloat64(1, maxVal-minVal) * float64(drawArea.Dy()-1))
@@ -158,6 +196,7 @@ func (self *Plot) renderDot(buf *Buffer, drawArea image.Rectangle, minVal float6
 }
 
 func (self *Plot) plotAxes(buf *Buffer, minVal, maxVal float64) {
+	xAxisLabelsHeight := self.xAxisLabelsHeight()
 	// draw origin cell
 	buf.SetCell(
 		NewCell(BOTTOM_LEFT, NewStyle(ColorWhite)),
@@ -179,11 +218,11 @@ func (self *Plot) plotAxes(buf *Buffer, minVal, maxVal float64) {
 	}
 	// draw y axis labels
 	verticalScale := (maxVal - minVal) / float64(self.Inner.Dy()-xAxisLabelsHeight-1)
-	for i := 0; i*(yAxisLabelsGap+1) < self.Inner.Dy()-1; i++ {
+	for i := 0; i*(yAxisLabelsGap+1) < self.Inner.Dy()-xAxisLabelsHeight; i++ {
 		buf.SetString(
-			fmt.Sprintf("%.2f", float64(i)*verticalScale*(yAxisLabelsGap+1)+minVal),
+			self.NumFormatter(float64(i)*verticalScale*(yAxisLabelsGap+1)+minVal),
 			NewStyle(ColorWhite),
-			image.Pt(self.Inner.Min.X, self.Inner.Max.Y-(i*(yAxisLabelsGap+1))-2),
+			image.Pt(self.Inner.Min.X, self.Inner.Max.Y-(i*(yAxisLabelsGap+1))-xAxisLabelsHeight-1),
 		)
 	}
 	switch self.PlotType {
@@ -195,7 +234,7 @@ func (self *Plot) plotAxes(buf *Buffer, minVal, maxVal float64) {
 
 		for x := self.Inner.Min.X + yAxisLabelsWidth; x < self.Inner.Max.X-1; {
 			index := (x - (self.Inner.Min.X + yAxisLabelsWidth)) / (self.HorizontalScale)
-			label := fmt.Sprintf("%.02f", self.XMinVal+(float64(index)*(self.XMaxVal-self.XMinVal)/float64(self.Inner.Dx()-yAxisLabelsWidth-1)))
+			label := self.NumFormatter(self.XMinVal + (float64(index) * (self.XMaxVal - self.XMinVal) / float64(self.Inner.Dx()-yAxisLabelsWidth-1)))
 			if len(self.DataLabels) > index {
 				label = fmt.Sprintf(
 					"%s",
@@ -210,11 +249,15 @@ func (self *Plot) plotAxes(buf *Buffer, minVal, maxVal float64) {
 			x += (len(label) + xAxisLabelsGap) * self.HorizontalScale
 		}
 	case LineChart:
+		if len(self.Categories) > 0 {
+			self.drawCategoryLabels(buf, self.drawArea())
+			break
+		}
 		// draw x axis labels
 		// draw first label or 0
-		firstLabel := "0"
-		if len(self.DataLabels) > 0 {
-			firstLabel = self.DataLabels[0]
+		firstLabel := fmt.Sprintf("%d", self.XOffset)
+		if len(self.DataLabels) > self.XOffset {
+			firstLabel = self.DataLabels[self.XOffset]
 		}
 		buf.SetString(
 			firstLabel,
@@ -223,7 +266,7 @@ func (self *Plot) plotAxes(buf *Buffer, minVal, maxVal float64) {
 		)
 		// draw rest
 		for x := self.Inner.Min.X + yAxisLabelsWidth + (xAxisLabelsGap+len(firstLabel)-1)*self.HorizontalScale + 1; x < self.Inner.Max.X-1; {
-			index := int((x-(self.Inner.Min.X+yAxisLabelsWidth)-1)/(self.HorizontalScale) + 1)
+			index := int((x-(self.Inner.Min.X+yAxisLabelsWidth)-1)/(self.HorizontalScale)+1) + self.XOffset
 			label := fmt.Sprintf("%d", index)
 			if len(self.DataLabels) > index {
 				label = fmt.Sprintf(
@@ -241,6 +284,17 @@ func (self *Plot) plotAxes(buf *Buffer, minVal, maxVal float64) {
 	}
 }
 
+// drawArea returns the area of the data points, which is the inner area without the axes.
+func (self *Plot) drawArea() image.Rectangle {
+	if !self.ShowAxes {
+		return self.Inner
+	}
+	return image.Rect(
+		self.Inner.Min.X+yAxisLabelsWidth+1, self.Inner.Min.Y,
+		self.Inner.Max.X, self.Inner.Max.Y-self.xAxisLabelsHeight()-1,
+	)
+}
+
 func (self *Plot) Draw(buf *Buffer) {
 	self.Block.Draw(buf)
 
@@ -254,13 +308,7 @@ func (self *Plot) Draw(buf *Buffer) {
 		self.plotAxes(buf, self.MinVal, self.MaxVal)
 	}
 
-	drawArea := self.Inner
-	if self.ShowAxes {
-		drawArea = image.Rect(
-			self.Inner.Min.X+yAxisLabelsWidth+1, self.Inner.Min.Y,
-			self.Inner.Max.X, self.Inner.Max.Y-xAxisLabelsHeight-1,
-		)
-	}
+	drawArea := self.drawArea()
 
 	switch self.Marker {
 	case MarkerBraille:
@@ -268,4 +316,8 @@ func (self *Plot) Draw(buf *Buffer) {
 	case MarkerDot:
 		self.renderDot(buf, drawArea, self.MinVal, self.MaxVal)
 	}
+
+	if self.Measure {
+		self.drawMeasurement(buf, drawArea)
+	}
 }
@@ -0,0 +1,135 @@
+package widgets
+
+import (
+	"time"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// SeriesTransform derives the plotted value from a raw sample.
+// It returns false if no value can be derived yet, e.g. for the first sample of a delta.
+type SeriesTransform interface {
+	Transform(t time.Time, v float64) (float64, bool)
+}
+
+// SeriesTransformFunc is a stateless SeriesTransform.
+type SeriesTransformFunc func(t time.Time, v float64) (float64, bool)
+
+func (self SeriesTransformFunc) Transform(t time.Time, v float64) (float64, bool) {
+	return self(t, v)
+}
+
+// DeltaTransform yields the difference to the previous sample.
+type DeltaTransform struct {
+	previous    float64
+	hasPrevious bool
+}
+
+func (self *DeltaTransform) Transform(t time.Time, v float64) (float64, bool) {
+	previous, ok := self.previous, self.hasPrevious
+	self.previous, self.hasPrevious = v, true
+	return v - previous, ok
+}
+
+// RateTransform yields the change per second since the previous sample,
+// which turns ever increasing counters into rates.
+type RateTransform struct {
+	previous     float64
+	previousTime time.Time
+}
+
+func (self *RateTransform) Transform(t time.Time, v float64) (float64, bool) {
+	previous, previousTime := self.previous, self.previousTime
+	self.previous, self.previousTime = v, t
+	if previousTime.IsZero() || !t.After(previousTime) {
+		return 0, false
+	}
+	return (v - previous) / t.Sub(previousTime).Seconds(), true
+}
+
+// CumulativeSumTransform yields the sum of all samples so far.
+type CumulativeSumTransform struct {
+	sum float64
+}
+
+func (self *CumulativeSumTransform) Transform(t time.Time, v float64) (float64, bool) {
+	self.sum += v
+	return self.sum, true
+}
+
+// EMATransform yields the exponential moving average of the samples.
+// Alpha is the weight of the newest sample, between 0 and 1.
+type EMATransform struct {
+	Alpha float64
+
+	average    float64
+	hasAverage bool
+}
+
+func (self *EMATransform) Transform(t time.Time, v float64) (float64, bool) {
+	if !self.hasAverage {
+		self.average, self.hasAverage = v, true
+	} else {
+		self.average = self.Alpha*v + (1-self.Alpha)*self.average
+	}
+	return self.average, true
+}
+
+// ChainTransforms applies the given transforms one after another, e.g. a rate followed by an EMA.
+func ChainTransforms(transforms ...SeriesTransform) SeriesTransform {
+	return SeriesTransformFunc(func(t time.Time, v float64) (float64, bool) {
+		for _, transform := range transforms {
+			var ok bool
+			if v, ok = transform.Transform(t, v); !ok {
+				return 0, false
+			}
+		}
+		return v, true
+	})
+}
+
+// PlotBinding feeds samples from a data source into a line of a Plot, applying an
+// optional Transform so that the app doesn't have to keep track of previous values.
+type PlotBinding struct {
+	Plot      *Plot
+	Line      int
+	Transform SeriesTransform
+	// MaxPoints limits the length of the line by dropping the oldest points. 0 means unlimited.
+	MaxPoints int
+}
+
+func NewPlotBinding(plot *Plot, line int, transform SeriesTransform) *PlotBinding {
+	return &PlotBinding{
+		Plot:      plot,
+		Line:      line,
+		Transform: transform,
+	}
+}
+
+// Push adds a sample taken now according to DefaultClock.
+func (self *PlotBinding) Push(v float64) {
+	self.PushAt(DefaultClock.Now(), v)
+}
+
+// PushAt adds a sample taken at the given time. The Transform runs with the Plot locked,
+// so that samples can be pushed from several goroutines.
+func (self *PlotBinding) PushAt(t time.Time, v float64) {
+	self.Plot.Lock()
+	defer self.Plot.Unlock()
+
+	if self.Transform != nil {
+		var ok bool
+		if v, ok = self.Transform.Transform(t, v); !ok {
+			return
+		}
+	}
+
+	for len(self.Plot.Data) <= self.Line {
+		self.Plot.Data = append(self.Plot.Data, []float64{})
+	}
+	line := append(self.Plot.Data[self.Line], v)
+	if self.MaxPoints > 0 && len(line) > self.MaxPoints {
+		line = line[len(line)-self.MaxPoints:]
+	}
+	self.Plot.Data[self.Line] = line
+}
@@ -0,0 +1,76 @@
+package widgets
+
+import (
+	"image"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// plotCategory is a section of consecutive data points with the same category.
+type plotCategory struct {
+	name       string
+	start, end int
+}
+
+// categorySections returns the sections of the Categories, starting at XOffset.
+func (self *Plot) categorySections() []plotCategory {
+	sections := []plotCategory{}
+	for i := self.XOffset; i < len(self.Categories); i++ {
+		if n := len(sections); n > 0 && sections[n-1].name == self.Categories[i] {
+			sections[n-1].end = i + 1
+			continue
+		}
+		sections = append(sections, plotCategory{self.Categories[i], i, i + 1})
+	}
+	return sections
+}
+
+// xAxisLabelsHeight returns the number of rows below the x axis, which is more than one
+// for rotated category labels.
+func (self *Plot) xAxisLabelsHeight() int {
+	if len(self.Categories) == 0 || !self.RotateCategoryLabels || self.PlotType != LineChart {
+		return xAxisLabelsHeight
+	}
+	height := xAxisLabelsHeight
+	for _, section := range self.categorySections() {
+		height = MaxInt(height, rw.StringWidth(section.name))
+	}
+	// leave at least half of the widget to the data
+	return MaxInt(MinInt(height, self.Inner.Dy()/2-1), xAxisLabelsHeight)
+}
+
+// drawCategoryLabels draws a tick at the start of every category section on the x axis,
+// with the category name next to it.
+func (self *Plot) drawCategoryLabels(buf *Buffer, drawArea image.Rectangle) {
+	axisY := drawArea.Max.Y
+	labelsHeight := self.Inner.Max.Y - axisY - 1
+	for _, section := range self.categorySections() {
+		x := self.plotColumn(drawArea, float64(section.start))
+		if x >= self.Inner.Max.X {
+			break
+		}
+		if x > drawArea.Min.X {
+			buf.SetCell(NewCell(HORIZONTAL_DOWN, NewStyle(ColorWhite)), image.Pt(x, axisY))
+		}
+		if self.RotateCategoryLabels {
+			label := TrimString(section.name, labelsHeight)
+			y := axisY + 1
+			for _, r := range label {
+				buf.SetCell(NewCell(r, NewStyle(ColorWhite)), image.Pt(x, y))
+				y++
+			}
+			continue
+		}
+		// the label may use the width of its section, except for a gap to the next label
+		end := MinInt(self.plotColumn(drawArea, float64(section.end))-1, self.Inner.Max.X)
+		if end-x < 1 {
+			continue
+		}
+		buf.SetString(
+			TrimString(section.name, end-x),
+			NewStyle(ColorWhite),
+			image.Pt(x, self.Inner.Max.Y-1),
+		)
+	}
+}
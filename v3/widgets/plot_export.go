@@ -0,0 +1,200 @@
+package widgets
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"io"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+const plotExportMargin = 10
+
+// plotSeries returns the data of the Plot as lists of points in data coordinates.
+func (self *Plot) plotSeries() [][][2]float64 {
+	series := [][][2]float64{}
+	switch self.PlotType {
+	case ScatterPlot:
+		if len(self.Data) < 2 {
+			return series
+		}
+		points := [][2]float64{}
+		for i, x := range self.Data[0] {
+			if i < len(self.Data[1]) {
+				points = append(points, [2]float64{x, self.Data[1][i]})
+			}
+		}
+		series = append(series, points)
+	case LineChart:
+		for _, line := range self.Data {
+			points := make([][2]float64, len(line))
+			for i, val := range line {
+				points[i] = [2]float64{float64(i), val}
+			}
+			series = append(series, points)
+		}
+	}
+	return series
+}
+
+// plotBounds returns the data ranges used for exporting the Plot.
+func (self *Plot) plotBounds(series [][][2]float64) (xMin, xMax, yMin, yMax float64) {
+	xMin, xMax = self.XMinVal, self.XMaxVal
+	yMin, yMax = self.MinVal, self.MaxVal
+	for _, points := range series {
+		for _, p := range points {
+			xMin, xMax = MinFloat64(xMin, p[0]), MaxFloat64(xMax, p[0])
+			yMin, yMax = MinFloat64(yMin, p[1]), MaxFloat64(yMax, p[1])
+		}
+	}
+	if xMax <= xMin {
+		xMax = xMin + 1
+	}
+	if yMax <= yMin {
+		yMax = yMin + 1
+	}
+	return
+}
+
+// exportProjection returns a function mapping data coordinates to pixel coordinates.
+func (self *Plot) exportProjection(series [][][2]float64, width, height int) func([2]float64) image.Point {
+	xMin, xMax, yMin, yMax := self.plotBounds(series)
+	plotWidth := float64(width - 2*plotExportMargin - 1)
+	plotHeight := float64(height - 2*plotExportMargin - 1)
+	return func(p [2]float64) image.Point {
+		return image.Pt(
+			plotExportMargin+int(RoundFloat64((p[0]-xMin)/(xMax-xMin)*plotWidth)),
+			height-plotExportMargin-1-int(RoundFloat64((p[1]-yMin)/(yMax-yMin)*plotHeight)),
+		)
+	}
+}
+
+func colorToRGBA(c Color, fallback color.RGBA) color.RGBA {
+	r, g, b, ok := ColorToRGB(c)
+	if !ok {
+		return fallback
+	}
+	return color.RGBA{r, g, b, 255}
+}
+
+// RenderImage rasterizes the current Plot data into an image of the given size.
+// The result can be saved with the image/png package to snapshot charts for reports.
+func (self *Plot) RenderImage(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.RGBA{0, 0, 0, 255}), image.Point{}, draw.Src)
+
+	series := self.plotSeries()
+	project := self.exportProjection(series, width, height)
+
+	if self.ShowAxes {
+		axesColor := colorToRGBA(self.AxesColor, color.RGBA{255, 255, 255, 255})
+		origin := image.Pt(plotExportMargin, height-plotExportMargin-1)
+		drawImageLine(img, origin, image.Pt(width-plotExportMargin-1, origin.Y), axesColor)
+		drawImageLine(img, origin, image.Pt(origin.X, plotExportMargin), axesColor)
+	}
+
+	for i, points := range series {
+		lineColor := colorToRGBA(SelectColor(self.LineColors, i), color.RGBA{255, 255, 255, 255})
+		for j, p := range points {
+			switch {
+			case self.PlotType == ScatterPlot:
+				pixel := project(p)
+				draw.Draw(img, image.Rect(pixel.X-1, pixel.Y-1, pixel.X+2, pixel.Y+2), image.NewUniform(lineColor), image.Point{}, draw.Src)
+			case j > 0:
+				drawImageLine(img, project(points[j-1]), project(p), lineColor)
+			}
+		}
+	}
+
+	return img
+}
+
+// drawImageLine draws a line between two points using Bresenham's algorithm.
+func drawImageLine(img *image.RGBA, p0, p1 image.Point, c color.RGBA) {
+	dx := AbsInt(p1.X - p0.X)
+	dy := -AbsInt(p1.Y - p0.Y)
+	sx, sy := 1, 1
+	if p0.X > p1.X {
+		sx = -1
+	}
+	if p0.Y > p1.Y {
+		sy = -1
+	}
+	err := dx + dy
+	for {
+		img.SetRGBA(p0.X, p0.Y, c)
+		if p0 == p1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			p0.X += sx
+		}
+		if e2 <= dx {
+			err += dx
+			p0.Y += sy
+		}
+	}
+}
+
+// RenderSVG writes the current Plot data as an SVG document of the given size to w.
+func (self *Plot) RenderSVG(w io.Writer, width, height int) error {
+	series := self.plotSeries()
+	project := self.exportProjection(series, width, height)
+
+	hex := func(c Color) string {
+		rgba := colorToRGBA(c, color.RGBA{255, 255, 255, 255})
+		return fmt.Sprintf("#%02x%02x%02x", rgba.R, rgba.G, rgba.B)
+	}
+
+	if _, err := fmt.Fprintf(w,
+		"<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\">\n<rect width=\"100%%\" height=\"100%%\" fill=\"#000000\"/>\n",
+		width, height, width, height,
+	); err != nil {
+		return err
+	}
+
+	if self.ShowAxes {
+		if _, err := fmt.Fprintf(w,
+			"<polyline points=\"%d,%d %d,%d %d,%d\" fill=\"none\" stroke=\"%s\"/>\n",
+			plotExportMargin, plotExportMargin,
+			plotExportMargin, height-plotExportMargin-1,
+			width-plotExportMargin-1, height-plotExportMargin-1,
+			hex(self.AxesColor),
+		); err != nil {
+			return err
+		}
+	}
+
+	for i, points := range series {
+		stroke := hex(SelectColor(self.LineColors, i))
+		switch self.PlotType {
+		case ScatterPlot:
+			for _, p := range points {
+				pixel := project(p)
+				if _, err := fmt.Fprintf(w, "<circle cx=\"%d\" cy=\"%d\" r=\"2\" fill=\"%s\"/>\n", pixel.X, pixel.Y, stroke); err != nil {
+					return err
+				}
+			}
+		case LineChart:
+			if _, err := io.WriteString(w, "<polyline points=\""); err != nil {
+				return err
+			}
+			for _, p := range points {
+				pixel := project(p)
+				if _, err := fmt.Fprintf(w, "%d,%d ", pixel.X, pixel.Y); err != nil {
+					return err
+				}
+			}
+			if _, err := fmt.Fprintf(w, "\" fill=\"none\" stroke=\"%s\"/>\n", stroke); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, "</svg>\n")
+	return err
+}
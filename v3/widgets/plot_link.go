@@ -0,0 +1,92 @@
+package widgets
+
+import (
+	. "github.com/s-westphal/termui/v3"
+)
+
+// LinkGroup synchronizes the X viewport and the measurement cursors of multiple Plots.
+// Zooming, panning or moving a cursor in one of the Plots updates all others.
+type LinkGroup struct {
+	Plots []*Plot
+}
+
+// LinkPlots creates a LinkGroup from the given plots, which take over the viewport of the first one.
+func LinkPlots(plots ...*Plot) *LinkGroup {
+	group := &LinkGroup{}
+	for _, plot := range plots {
+		group.Add(plot)
+	}
+	return group
+}
+
+// Add links a Plot to the group. It takes over the viewport of the group.
+func (self *LinkGroup) Add(plot *Plot) {
+	if plot.linkGroup != nil {
+		plot.linkGroup.Remove(plot)
+	}
+	plot.linkGroup = self
+	self.Plots = append(self.Plots, plot)
+	if len(self.Plots) > 1 {
+		self.Sync(self.Plots[0])
+	}
+}
+
+// Remove unlinks a Plot from the group.
+func (self *LinkGroup) Remove(plot *Plot) {
+	for i, p := range self.Plots {
+		if p == plot {
+			self.Plots = append(self.Plots[:i], self.Plots[i+1:]...)
+			plot.linkGroup = nil
+			return
+		}
+	}
+}
+
+// Sync copies the viewport and cursors of source to all other Plots of the group.
+func (self *LinkGroup) Sync(source *Plot) {
+	for _, plot := range self.Plots {
+		if plot == source {
+			continue
+		}
+		plot.Lock()
+		plot.HorizontalScale = source.HorizontalScale
+		plot.XOffset = source.XOffset
+		plot.XMinVal = source.XMinVal
+		plot.XMaxVal = source.XMaxVal
+		plot.Measure = source.Measure
+		plot.Cursors = source.Cursors
+		plot.ActiveCursor = source.ActiveCursor
+		plot.Unlock()
+	}
+}
+
+// sync propagates the viewport of the Plot to its LinkGroup.
+func (self *Plot) sync() {
+	if self.linkGroup != nil {
+		self.linkGroup.Sync(self)
+	}
+}
+
+// ZoomIn increases the HorizontalScale.
+func (self *Plot) ZoomIn() {
+	self.HorizontalScale++
+	self.sync()
+}
+
+// ZoomOut decreases the HorizontalScale.
+func (self *Plot) ZoomOut() {
+	self.HorizontalScale = MaxInt(self.HorizontalScale-1, 1)
+	self.sync()
+}
+
+// Pan moves the viewport of line charts by amount data points. If amount is < 0, then pan left.
+func (self *Plot) Pan(amount int) {
+	self.XOffset = MaxInt(self.XOffset+amount, 0)
+	self.sync()
+}
+
+// SetMeasure enables or disables the measurement cursors.
+func (self *Plot) SetMeasure(measure bool) {
+	self.Measure = measure
+	self.sync()
+}
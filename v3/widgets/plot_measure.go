@@ -0,0 +1,110 @@
+package widgets
+
+import (
+	"fmt"
+	"image"
+	"math"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// plotColumn returns the column of the draw area at which the given x value is drawn.
+// For line charts x is the index of a data point.
+func (self *Plot) plotColumn(drawArea image.Rectangle, x float64) int {
+	if self.PlotType == ScatterPlot {
+		xDx := MaxFloat64(1, self.XMaxVal-self.XMinVal)
+		return drawArea.Min.X + int((x-self.XMinVal)*float64(self.HorizontalScale*(drawArea.Dx()-1))/xDx)
+	}
+	return drawArea.Min.X + (int(x)-self.XOffset)*self.HorizontalScale
+}
+
+// valueAt returns the y value of a line at x. For scatter plots the point closest to x is used.
+func (self *Plot) valueAt(line int, x float64) (float64, bool) {
+	switch self.PlotType {
+	case ScatterPlot:
+		if len(self.Data) < 2 || line > 0 {
+			return 0, false
+		}
+		closest := -1
+		for i, px := range self.Data[0] {
+			if i < len(self.Data[1]) && (closest == -1 || math.Abs(px-x) < math.Abs(self.Data[0][closest]-x)) {
+				closest = i
+			}
+		}
+		if closest == -1 {
+			return 0, false
+		}
+		return self.Data[1][closest], true
+	default:
+		index := int(RoundFloat64(x))
+		if line >= len(self.Data) || index < 0 || index >= len(self.Data[line]) {
+			return 0, false
+		}
+		return self.Data[line][index], true
+	}
+}
+
+// MoveCursor moves the active measurement cursor by amount data points, or x units for scatter plots.
+func (self *Plot) MoveCursor(amount float64) {
+	self.Cursors[self.ActiveCursor] += amount
+	self.sync()
+}
+
+// SwitchCursor makes the other measurement cursor the active one.
+func (self *Plot) SwitchCursor() {
+	self.ActiveCursor = 1 - self.ActiveCursor
+	self.sync()
+}
+
+// drawMeasurement draws the measurement cursors and a box with the distance between them.
+func (self *Plot) drawMeasurement(buf *Buffer, drawArea image.Rectangle) {
+	for i, cursor := range self.Cursors {
+		style := self.CursorStyle
+		if i == self.ActiveCursor {
+			style.Modifier |= ModifierBold
+		}
+		x := self.plotColumn(drawArea, cursor)
+		if x < drawArea.Min.X || x >= drawArea.Max.X {
+			continue
+		}
+		for y := drawArea.Min.Y; y < drawArea.Max.Y; y++ {
+			buf.SetCell(NewCell(VERTICAL_LINE, style), image.Pt(x, y))
+		}
+	}
+
+	formatX := self.MeasureFormatter
+	if formatX == nil {
+		formatX = self.NumFormatter
+	}
+	type measureLine struct {
+		text  string
+		color Color
+	}
+	lines := []measureLine{
+		{fmt.Sprintf("Δx %s", formatX(self.Cursors[1]-self.Cursors[0])), self.CursorStyle.Fg},
+	}
+	for i := range self.Data {
+		y0, ok0 := self.valueAt(i, self.Cursors[0])
+		y1, ok1 := self.valueAt(i, self.Cursors[1])
+		if ok0 && ok1 {
+			lines = append(lines, measureLine{
+				fmt.Sprintf("Δ%d %s", i+1, self.NumFormatter(y1-y0)),
+				SelectColor(self.LineColors, i),
+			})
+		}
+	}
+
+	width := 0
+	for _, line := range lines {
+		width = MaxInt(width, rw.StringWidth(line.text))
+	}
+	box := NewBlock()
+	box.BorderStyle = self.CursorStyle
+	box.SetRect(drawArea.Max.X-width-2, drawArea.Min.Y, drawArea.Max.X, drawArea.Min.Y+len(lines)+2)
+	buf.Fill(CellClear, box.Rectangle)
+	box.Draw(buf)
+	for i, line := range lines {
+		buf.SetString(line.text, NewStyle(line.color), image.Pt(box.Inner.Min.X, box.Inner.Min.Y+i))
+	}
+}
@@ -0,0 +1,145 @@
+package widgets
+
+import (
+	"image"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+const (
+	radioSelected   = "(•) "
+	radioUnselected = "( ) "
+	// radioSpacing is the number of cells between horizontal options.
+	radioSpacing = 2
+)
+
+// RadioGroup shows mutually exclusive Options, one per row or side by side if Horizontal
+// is set. The selection moves with the arrow keys or a click, see HandleEvent.
+type RadioGroup struct {
+	Block
+	Options        []string
+	SelectedOption int
+	Horizontal     bool
+
+	TextStyle     Style
+	SelectedStyle Style
+
+	// OnChange is called with the index of the selected option when it changes.
+	OnChange func(index int)
+}
+
+func NewRadioGroup(options ...string) *RadioGroup {
+	return &RadioGroup{
+		Block:         *NewBlock(),
+		Options:       options,
+		TextStyle:     Theme.Form.Input,
+		SelectedStyle: NewStyle(Theme.Form.Input.Fg, Theme.Form.Input.Bg, ModifierBold),
+	}
+}
+
+// Selected returns the selected option, or "" if there are no Options.
+func (self *RadioGroup) Selected() string {
+	if self.SelectedOption < 0 || self.SelectedOption >= len(self.Options) {
+		return ""
+	}
+	return self.Options[self.SelectedOption]
+}
+
+// SelectOption selects the option at index and calls OnChange if it changed.
+func (self *RadioGroup) SelectOption(index int) {
+	if index < 0 || index >= len(self.Options) || index == self.SelectedOption {
+		return
+	}
+	self.SelectedOption = index
+	if self.OnChange != nil {
+		self.OnChange(index)
+	}
+}
+
+// SelectNext selects the next option, wrapping around.
+func (self *RadioGroup) SelectNext() {
+	if len(self.Options) > 0 {
+		self.SelectOption((self.SelectedOption + 1) % len(self.Options))
+	}
+}
+
+// SelectPrevious selects the previous option, wrapping around.
+func (self *RadioGroup) SelectPrevious() {
+	if len(self.Options) > 0 {
+		self.SelectOption((self.SelectedOption - 1 + len(self.Options)) % len(self.Options))
+	}
+}
+
+// optionRects returns where the Options are drawn within the Inner rectangle.
+// Options that don't fit get empty rectangles.
+func (self *RadioGroup) optionRects() []image.Rectangle {
+	rects := make([]image.Rectangle, len(self.Options))
+	point := self.Inner.Min
+	for i, option := range self.Options {
+		width := MinInt(rw.StringWidth(radioUnselected+option), self.Inner.Max.X-point.X)
+		if width <= 0 || point.Y >= self.Inner.Max.Y {
+			break
+		}
+		rects[i] = image.Rect(point.X, point.Y, point.X+width, point.Y+1)
+		if self.Horizontal {
+			point.X += width + radioSpacing
+		} else {
+			point.Y++
+		}
+	}
+	return rects
+}
+
+func (self *RadioGroup) Draw(buf *Buffer) {
+	self.Block.Draw(buf)
+
+	for i, rect := range self.optionRects() {
+		if rect.Empty() {
+			break
+		}
+		text, style := radioUnselected+self.Options[i], self.TextStyle
+		if i == self.SelectedOption {
+			text, style = radioSelected+self.Options[i], self.SelectedStyle
+		}
+		buf.SetString(TrimString(text, rect.Dx()), style, rect.Min)
+	}
+}
+
+// HandleEvent moves the selection with the arrow keys, <Home> and <End>, and selects
+// clicked options. It returns whether the event was used.
+func (self *RadioGroup) HandleEvent(e Event) bool {
+	switch e.Type {
+	case KeyboardEvent:
+		switch e.ID {
+		case "<Up>", "<Left>":
+			self.SelectPrevious()
+		case "<Down>", "<Right>", "<Space>":
+			self.SelectNext()
+		case "<Home>":
+			self.SelectOption(0)
+		case "<End>":
+			self.SelectOption(len(self.Options) - 1)
+		default:
+			return false
+		}
+		return true
+	case MouseEvent:
+		mouse, ok := e.Payload.(Mouse)
+		if !ok || e.ID != "<MouseLeft>" || mouse.Drag {
+			return false
+		}
+		for i, rect := range self.optionRects() {
+			if image.Pt(mouse.X, mouse.Y).In(rect) {
+				self.SelectOption(i)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// FormValue returns the selected option, see Form.
+func (self *RadioGroup) FormValue() interface{} {
+	return self.Selected()
+}
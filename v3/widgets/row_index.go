@@ -0,0 +1,43 @@
+package widgets
+
+import (
+	. "github.com/s-westphal/termui/v3"
+)
+
+// rowIndex maps the positions of the visible rows of a widget to row indices.
+// Without filtering, the rows from first to count are visible and their indices aren't stored,
+// so that huge data providers don't have to be walked.
+type rowIndex struct {
+	indices []int
+	first   int
+	count   int
+}
+
+func (self rowIndex) Len() int {
+	if self.indices == nil {
+		return MaxInt(self.count-self.first, 0)
+	}
+	return len(self.indices)
+}
+
+// At returns the row index at the given position.
+func (self rowIndex) At(position int) int {
+	if self.indices == nil {
+		return position + self.first
+	}
+	return self.indices[position]
+}
+
+// Position returns the position of the given row. If the row is hidden,
+// the position of the next visible row is returned instead.
+func (self rowIndex) Position(row int) int {
+	if self.indices == nil {
+		return MaxInt(MinInt(row-self.first, self.Len()-1), 0)
+	}
+	for position, i := range self.indices {
+		if i >= row {
+			return position
+		}
+	}
+	return MaxInt(len(self.indices)-1, 0)
+}
@@ -0,0 +1,135 @@
+package widgets
+
+import (
+	"fmt"
+	"image"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// Select shows one of its Options between arrows, which cycle through the Options
+// with <Left>, <Right>, <Space> or a click, see HandleEvent. With a Dropdown, <Space>,
+// <Enter> and clicks open a list of the Options instead.
+type Select struct {
+	Block
+	Options        []string
+	SelectedOption int
+	TextStyle      Style
+
+	// Dropdown is the list of Options that is opened if it is set, e.g. with
+	// NewSelectDropdown(). Like ContextMenus.Menu, it has to be rendered after all other
+	// widgets so that it is drawn on top. Typing while it is open filters the Options.
+	Dropdown *List
+	// DropdownRows is the maximum number of Options shown in the Dropdown, 0 shows all.
+	DropdownRows int
+	// DropdownBounds is used to open the Dropdown above the Select if it doesn't fit below.
+	DropdownBounds image.Rectangle
+
+	// OnChange is called with the index of the selected option when it changes.
+	OnChange func(index int)
+}
+
+func NewSelect(options ...string) *Select {
+	return &Select{
+		Block:     *NewBlock(),
+		Options:   options,
+		TextStyle: Theme.Form.Input,
+	}
+}
+
+// Selected returns the selected option, or "" if there are no Options.
+func (self *Select) Selected() string {
+	if self.SelectedOption < 0 || self.SelectedOption >= len(self.Options) {
+		return ""
+	}
+	return self.Options[self.SelectedOption]
+}
+
+// SelectOption selects the option at index and calls OnChange if it changed.
+func (self *Select) SelectOption(index int) {
+	if index < 0 || index >= len(self.Options) || index == self.SelectedOption {
+		return
+	}
+	self.SelectedOption = index
+	if self.OnChange != nil {
+		self.OnChange(index)
+	}
+}
+
+// SelectNext selects the next option, wrapping around.
+func (self *Select) SelectNext() {
+	if len(self.Options) > 0 {
+		self.SelectOption((self.SelectedOption + 1) % len(self.Options))
+	}
+}
+
+// SelectPrevious selects the previous option, wrapping around.
+func (self *Select) SelectPrevious() {
+	if len(self.Options) > 0 {
+		self.SelectOption((self.SelectedOption - 1 + len(self.Options)) % len(self.Options))
+	}
+}
+
+func (self *Select) Draw(buf *Buffer) {
+	self.Block.Draw(buf)
+
+	option := TrimString(self.Selected(), self.Inner.Dx()-4)
+	buf.SetString(
+		TrimString(fmt.Sprintf("%c %s %c", QUOTA_LEFT, option, QUOTA_RIGHT), self.Inner.Dx()),
+		self.TextStyle,
+		image.Pt(self.Inner.Min.X, self.Inner.Min.Y),
+	)
+}
+
+// HandleEvent cycles through the Options with <Left>, <Right> and <Space>. Clicking
+// the left arrow selects the previous option, clicking elsewhere the next one.
+// With a Dropdown, <Space>, <Enter> and clicks open it, see Open.
+// It returns whether the event was used.
+func (self *Select) HandleEvent(e Event) bool {
+	if self.IsOpen() {
+		return self.handleDropdownEvent(e)
+	}
+	switch e.Type {
+	case KeyboardEvent:
+		switch e.ID {
+		case "<Space>":
+			if self.Dropdown != nil {
+				self.Open()
+			} else {
+				self.SelectNext()
+			}
+		case "<Enter>":
+			if self.Dropdown == nil {
+				return false
+			}
+			self.Open()
+		case "<Left>":
+			self.SelectPrevious()
+		case "<Right>":
+			self.SelectNext()
+		default:
+			return false
+		}
+		return true
+	case MouseEvent:
+		mouse, ok := e.Payload.(Mouse)
+		if !ok || e.ID != "<MouseLeft>" || mouse.Drag || !image.Pt(mouse.X, mouse.Y).In(self.Inner) {
+			return false
+		}
+		if self.Dropdown != nil {
+			self.Open()
+		} else if mouse.X < self.Inner.Min.X+rw.RuneWidth(QUOTA_LEFT) {
+			self.SelectPrevious()
+		} else {
+			self.SelectNext()
+		}
+		return true
+	}
+	return false
+}
+
+// FormValue returns the selected option, see Form.
+func (self *Select) FormValue() interface{} {
+	return self.Selected()
+}
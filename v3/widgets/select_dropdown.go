@@ -0,0 +1,123 @@
+package widgets
+
+import (
+	"image"
+	"unicode"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// NewSelectDropdown returns a List for Select.Dropdown, which highlights typed-ahead text
+// in the options.
+func NewSelectDropdown() *List {
+	dropdown := NewList()
+	dropdown.SelectedRowStyle = Theme.Menu.Selected
+	dropdown.Matcher = FuzzyMatcher
+	dropdown.SetRect(0, 0, 0, 0)
+	return dropdown
+}
+
+// IsOpen reports whether the Dropdown is shown.
+func (self *Select) IsOpen() bool {
+	return self.Dropdown != nil && !self.Dropdown.Empty()
+}
+
+// Open shows the Dropdown below the Select, or above it if it would extend past
+// DropdownBounds. The selected option is highlighted and the type-ahead query is cleared.
+func (self *Select) Open() {
+	if self.Dropdown == nil || len(self.Options) == 0 {
+		return
+	}
+	self.Dropdown.Rows = self.Options
+	self.Dropdown.Query = ""
+	self.Dropdown.Title = ""
+	self.Dropdown.SelectedRow = MaxInt(self.SelectedOption, 0)
+
+	width := 0
+	for _, option := range self.Options {
+		width = MaxInt(width, rw.StringWidth(option))
+	}
+	rows := len(self.Options)
+	if self.DropdownRows > 0 {
+		rows = MinInt(rows, self.DropdownRows)
+	}
+	rect := self.GetRect()
+	width = MaxInt(width+2, rect.Dx())
+	height := rows + 2
+	dropdown := image.Rect(rect.Min.X, rect.Max.Y, rect.Min.X+width, rect.Max.Y+height)
+	if !self.DropdownBounds.Empty() && dropdown.Max.Y > self.DropdownBounds.Max.Y {
+		dropdown = dropdown.Sub(image.Pt(0, height+rect.Dy()))
+	}
+	self.Dropdown.SetRect(dropdown.Min.X, dropdown.Min.Y, dropdown.Max.X, dropdown.Max.Y)
+}
+
+// Close hides the Dropdown without changing the selection.
+func (self *Select) Close() {
+	if self.Dropdown != nil {
+		self.Dropdown.SetRect(0, 0, 0, 0)
+	}
+}
+
+// choose selects the highlighted option of the Dropdown and closes it.
+func (self *Select) choose() {
+	rows := self.Dropdown.visibleRows()
+	if rows.Len() > 0 && rows.Position(self.Dropdown.SelectedRow) < rows.Len() {
+		self.SelectOption(self.Dropdown.SelectedRow)
+	}
+	self.Close()
+}
+
+// setQuery filters the Dropdown and highlights the first matching option.
+func (self *Select) setQuery(query string) {
+	self.Dropdown.Query = query
+	self.Dropdown.Title = query
+	if rows := self.Dropdown.visibleRows(); rows.Len() > 0 {
+		self.Dropdown.SelectedRow = rows.At(0)
+	}
+}
+
+// handleDropdownEvent navigates the open Dropdown with <Up> and <Down>, filters it with
+// typed text, chooses an option with <Enter> or a click and closes it with <Escape>
+// or a click outside. All keyboard events are used while the Dropdown is open.
+func (self *Select) handleDropdownEvent(e Event) bool {
+	switch e.Type {
+	case KeyboardEvent:
+		switch e.ID {
+		case "<Up>", "<C-p>":
+			self.Dropdown.ScrollUp()
+		case "<Down>", "<C-n>":
+			self.Dropdown.ScrollDown()
+		case "<Enter>", "<Tab>":
+			self.choose()
+		case "<Escape>":
+			self.Close()
+		case "<Backspace>", "<C-<Backspace>>":
+			if query := []rune(self.Dropdown.Query); len(query) > 0 {
+				self.setQuery(string(query[:len(query)-1]))
+			}
+		case "<Space>":
+			self.setQuery(self.Dropdown.Query + " ")
+		default:
+			if r := []rune(e.ID); len(r) == 1 && unicode.IsPrint(r[0]) {
+				self.setQuery(self.Dropdown.Query + e.ID)
+			}
+		}
+		return true
+	case MouseEvent:
+		mouse, ok := e.Payload.(Mouse)
+		if !ok || e.ID != "<MouseLeft>" || mouse.Drag {
+			return false
+		}
+		point := image.Pt(mouse.X, mouse.Y)
+		if row := self.Dropdown.rowAt(point); row >= 0 {
+			self.Dropdown.SelectedRow = row
+			self.choose()
+			return true
+		}
+		self.Close()
+		// clicks on the Select only close the Dropdown
+		return point.In(self.Dropdown.GetRect()) || point.In(self.GetRect())
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package widgets
+
+import (
+	"image"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+type SkeletonShape uint
+
+const (
+	// SkeletonLines looks like lines of text, e.g. for Paragraph, List, Table or Tree.
+	SkeletonLines SkeletonShape = iota
+	// SkeletonBars looks like vertical bars, e.g. for BarChart, Sparkline or Plot.
+	SkeletonBars
+	// SkeletonFill fills the whole widget, e.g. for Gauge or Image.
+	SkeletonFill
+)
+
+const skeletonShimmerWidth = 6
+
+// skeletonLengths are the relative lengths of the lines and bars of a skeleton.
+var skeletonLengths = []float64{0.9, 0.6, 0.8, 0.45, 0.7, 0.55}
+
+// Skeleton wraps a widget and draws an animated placeholder shaped like the
+// widget's content instead of the widget while Loading is true.
+// The shimmer advances each time the Skeleton is drawn.
+type Skeleton struct {
+	Drawable
+	Loading      bool
+	Shape        SkeletonShape
+	Style        Style
+	ShimmerStyle Style
+
+	frame int
+}
+
+func NewSkeleton(widget Drawable, shape SkeletonShape) *Skeleton {
+	return &Skeleton{
+		Drawable:     widget,
+		Loading:      true,
+		Shape:        shape,
+		Style:        Theme.Skeleton.Base,
+		ShimmerStyle: Theme.Skeleton.Shimmer,
+	}
+}
+
+func (self *Skeleton) Draw(buf *Buffer) {
+	if !self.Loading {
+		self.Drawable.Draw(buf)
+		return
+	}
+
+	// draw only the border and title of the wrapped widget
+	inner := self.GetRect()
+	if widget, ok := self.Drawable.(interface{ GetBlock() *Block }); ok {
+		widget.GetBlock().Draw(buf)
+		inner = widget.GetBlock().Inner
+	}
+
+	shimmerX := inner.Min.X + self.frame%(inner.Dx()+skeletonShimmerWidth) - skeletonShimmerWidth
+	self.frame++
+
+	cell := func(x int) Cell {
+		if x >= shimmerX && x < shimmerX+skeletonShimmerWidth {
+			return NewCell(SHADED_BLOCKS[2], self.ShimmerStyle)
+		}
+		return NewCell(SHADED_BLOCKS[1], self.Style)
+	}
+
+	switch self.Shape {
+	case SkeletonLines:
+		for i, y := 0, inner.Min.Y; y < inner.Max.Y; i, y = i+1, y+2 {
+			length := int(skeletonLengths[i%len(skeletonLengths)] * float64(inner.Dx()))
+			for x := inner.Min.X; x < inner.Min.X+length; x++ {
+				buf.SetCell(cell(x), image.Pt(x, y))
+			}
+		}
+	case SkeletonBars:
+		for i, x := 0, inner.Min.X; x < inner.Max.X; i, x = i+1, x+3 {
+			height := int(skeletonLengths[i%len(skeletonLengths)] * float64(inner.Dy()))
+			for bx := x; bx < MinInt(x+2, inner.Max.X); bx++ {
+				for y := inner.Max.Y - height; y < inner.Max.Y; y++ {
+					buf.SetCell(cell(bx), image.Pt(bx, y))
+				}
+			}
+		}
+	case SkeletonFill:
+		for x := inner.Min.X; x < inner.Max.X; x++ {
+			for y := inner.Min.Y; y < inner.Max.Y; y++ {
+				buf.SetCell(cell(x), image.Pt(x, y))
+			}
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package widgets
+
+import (
+	"fmt"
+	"image"
+	"math"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+const (
+	sliderThumb           = '●'
+	sliderFilled          = '━'
+	sliderFilledVertical  = '┃'
+	sliderTick            = '╵'
+	sliderTickVertical    = '╴'
+	sliderPageSteps       = 10
+	sliderDefaultDivision = 100
+)
+
+// Slider picks a Value between Min and Max, snapped to multiples of Step from Min.
+// It is horizontal unless Vertical is set, with Max at the top. The Value is changed with
+// the arrow keys, <PageUp>, <PageDown>, <Home> and <End>, or by clicking and dragging the
+// track, see HandleEvent.
+type Slider struct {
+	Block
+	Value float64
+	Min   float64
+	Max   float64
+	// Step is the amount the arrow keys change the Value by. If it is 0, the range is
+	// divided into 100 steps and the Value isn't snapped.
+	Step     float64
+	Vertical bool
+
+	// Ticks is the number of intervals the track is divided into by tick marks, which are
+	// drawn next to it. 0 draws no tick marks.
+	Ticks int
+
+	// ShowValue draws the Value formatted by ValueFormatter at the end of the track.
+	ShowValue      bool
+	ValueFormatter func(float64) string
+
+	TrackStyle  Style
+	FilledStyle Style
+	ThumbStyle  Style
+	LabelStyle  Style
+
+	// OnChange is called with the new Value when it changes.
+	OnChange func(value float64)
+
+	dragging bool
+}
+
+func NewSlider() *Slider {
+	return &Slider{
+		Block:          *NewBlock(),
+		Max:            100,
+		Step:           1,
+		ValueFormatter: func(value float64) string { return fmt.Sprint(value) },
+		TrackStyle:     Theme.Slider.Track,
+		FilledStyle:    Theme.Slider.Filled,
+		ThumbStyle:     Theme.Slider.Thumb,
+		LabelStyle:     Theme.Slider.Label,
+	}
+}
+
+// step returns the Step, or a hundredth of the range if the Step is 0.
+func (self *Slider) step() float64 {
+	if self.Step > 0 {
+		return self.Step
+	}
+	return (self.Max - self.Min) / sliderDefaultDivision
+}
+
+// clamp limits value to the range and snaps it to the Step.
+func (self *Slider) clamp(value float64) float64 {
+	if self.Step > 0 {
+		value = self.Min + math.Round((value-self.Min)/self.Step)*self.Step
+	}
+	return MaxFloat64(MinFloat64(value, self.Max), self.Min)
+}
+
+// SetValue changes the Value, clamped and snapped, and calls OnChange if it changed.
+func (self *Slider) SetValue(value float64) {
+	value = self.clamp(value)
+	if value == self.Value {
+		return
+	}
+	self.Value = value
+	if self.OnChange != nil {
+		self.OnChange(value)
+	}
+}
+
+// Increase increases the Value by steps times the Step.
+func (self *Slider) Increase(steps int) {
+	self.SetValue(self.Value + float64(steps)*self.step())
+}
+
+// Decrease decreases the Value by steps times the Step.
+func (self *Slider) Decrease(steps int) {
+	self.SetValue(self.Value - float64(steps)*self.step())
+}
+
+// label returns the formatted Value if ShowValue is set.
+func (self *Slider) label() string {
+	if !self.ShowValue || self.ValueFormatter == nil {
+		return ""
+	}
+	return self.ValueFormatter(self.Value)
+}
+
+// labelSize returns the cells reserved for the label: its width on the right of a horizontal
+// track and a row below a vertical track. The label of the Max reserves enough room for
+// the Value not to move the track while it changes.
+func (self *Slider) labelSize() int {
+	if !self.ShowValue || self.ValueFormatter == nil {
+		return 0
+	}
+	if self.Vertical {
+		return 1
+	}
+	width := MaxInt(rw.StringWidth(self.ValueFormatter(self.Min)), rw.StringWidth(self.ValueFormatter(self.Max)))
+	return MaxInt(width, rw.StringWidth(self.label())) + 1
+}
+
+// track returns the rectangle of the track, a row or column at the top or left of Inner.
+func (self *Slider) track() image.Rectangle {
+	if self.Vertical {
+		return image.Rect(self.Inner.Min.X, self.Inner.Min.Y, self.Inner.Min.X+1, self.Inner.Max.Y-self.labelSize())
+	}
+	return image.Rect(self.Inner.Min.X, self.Inner.Min.Y, self.Inner.Max.X-self.labelSize(), self.Inner.Min.Y+1)
+}
+
+// trackLength returns the number of cells of the track.
+func (self *Slider) trackLength() int {
+	track := self.track()
+	if self.Vertical {
+		return track.Dy()
+	}
+	return track.Dx()
+}
+
+// positionOf returns the cell of the track from the Min end that shows value.
+func (self *Slider) positionOf(value float64) int {
+	length := self.trackLength()
+	if length <= 1 || self.Max <= self.Min {
+		return 0
+	}
+	ratio := (value - self.Min) / (self.Max - self.Min)
+	return MaxInt(MinInt(int(math.Round(ratio*float64(length-1))), length-1), 0)
+}
+
+// valueAt returns the value shown at the given point of the track.
+func (self *Slider) valueAt(p image.Point) float64 {
+	length := self.trackLength()
+	if length <= 1 {
+		return self.Min
+	}
+	track := self.track()
+	position := p.X - track.Min.X
+	if self.Vertical {
+		position = track.Max.Y - 1 - p.Y
+	}
+	return self.Min + float64(position)/float64(length-1)*(self.Max-self.Min)
+}
+
+// pointAt returns the point of the cell at position from the Min end of the track.
+func (self *Slider) pointAt(position int) image.Point {
+	track := self.track()
+	if self.Vertical {
+		return image.Pt(track.Min.X, track.Max.Y-1-position)
+	}
+	return image.Pt(track.Min.X+position, track.Min.Y)
+}
+
+func (self *Slider) Draw(buf *Buffer) {
+	self.Block.Draw(buf)
+
+	length := self.trackLength()
+	if length <= 0 {
+		return
+	}
+	track, filled, tick, offset := HORIZONTAL_LINE, sliderFilled, sliderTick, image.Pt(0, 1)
+	if self.Vertical {
+		track, filled, tick, offset = VERTICAL_LINE, sliderFilledVertical, sliderTickVertical, image.Pt(1, 0)
+	}
+
+	thumb := self.positionOf(self.Value)
+	for i := 0; i < length; i++ {
+		cell := NewCell(track, self.TrackStyle)
+		switch {
+		case i == thumb:
+			cell = NewCell(sliderThumb, self.ThumbStyle)
+		case i < thumb:
+			cell = NewCell(filled, self.FilledStyle)
+		}
+		buf.SetCell(cell, self.pointAt(i))
+	}
+
+	if self.Ticks > 0 {
+		for i := 0; i <= self.Ticks; i++ {
+			value := self.Min + float64(i)/float64(self.Ticks)*(self.Max-self.Min)
+			point := self.pointAt(self.positionOf(value)).Add(offset)
+			if point.In(self.Inner) {
+				buf.SetCell(NewCell(tick, self.TrackStyle), point)
+			}
+		}
+	}
+
+	if label := self.label(); label != "" {
+		point := image.Pt(self.track().Max.X+1, self.Inner.Min.Y)
+		width := self.Inner.Max.X - point.X
+		if self.Vertical {
+			point, width = image.Pt(self.Inner.Min.X, self.Inner.Max.Y-1), self.Inner.Dx()
+		}
+		buf.SetString(TrimString(label, width), self.LabelStyle, point)
+	}
+}
+
+// HandleEvent changes the Value by a Step with the arrow keys, by ten Steps with <PageUp>
+// and <PageDown> and to the Min and Max with <Home> and <End>. Clicking the track sets
+// the Value, which follows the mouse while it is dragged. It returns whether the event was used.
+func (self *Slider) HandleEvent(e Event) bool {
+	switch e.Type {
+	case KeyboardEvent:
+		switch e.ID {
+		case "<Right>", "<Up>":
+			self.Increase(1)
+		case "<Left>", "<Down>":
+			self.Decrease(1)
+		case "<PageUp>":
+			self.Increase(sliderPageSteps)
+		case "<PageDown>":
+			self.Decrease(sliderPageSteps)
+		case "<Home>":
+			self.SetValue(self.Min)
+		case "<End>":
+			self.SetValue(self.Max)
+		default:
+			return false
+		}
+		return true
+	case MouseEvent:
+		mouse, ok := e.Payload.(Mouse)
+		if !ok {
+			return false
+		}
+		point := image.Pt(mouse.X, mouse.Y)
+		switch e.ID {
+		case "<MouseLeft>":
+			if !(mouse.Drag && self.dragging) && !point.In(self.track()) {
+				self.dragging = false
+				return false
+			}
+			self.dragging = true
+			self.SetValue(self.valueAt(point))
+			return true
+		case "<MouseRelease>":
+			if !self.dragging {
+				return false
+			}
+			self.dragging = false
+			return true
+		}
+	}
+	return false
+}
+
+// FormValue returns the Value, see Form.
+func (self *Slider) FormValue() interface{} {
+	return self.Value
+}
@@ -6,8 +6,13 @@ package widgets
 
 import (
 	"image"
+	"math"
+	"strings"
+
+	rw "github.com/mattn/go-runewidth"
 
 	. "github.com/s-westphal/termui/v3"
+	"github.com/s-westphal/termui/v3/drawille"
 )
 
 // Sparkline is like: ▅▆▂▂▅▇▂▂▃▆▆▆▅▃. The data points should be non-negative integers.
@@ -18,19 +23,51 @@ type Sparkline struct {
 	LineColor  Color
 	MaxVal     float64
 	MaxHeight  int // TODO
+
+	// MinVal is the baseline of the sparkline, values at or below it are drawn as the lowest bar.
+	// Together with MaxVal it fixes the range, so that the sparkline doesn't jump when peaks
+	// scroll out of view. MaxVal is derived from the Data if it is 0.
+	MinVal float64
+
+	// Thresholds draw the columns whose value reaches their Value in their color instead of
+	// the LineColor, so that spikes stand out. The highest threshold reached is used.
+	Thresholds []Threshold
+
+	// Braille draws the sparkline with braille dots, which have twice the horizontal
+	// and four times the vertical resolution of the block characters.
+	Braille bool
+
+	// ShowLast, ShowMin and ShowMax add the last, the lowest and the highest value right
+	// aligned in the first line of the sparkline, formatted with NumFormatter.
+	ShowLast        bool
+	ShowMin         bool
+	ShowMax         bool
+	NumFormatter    func(float64) string
+	AnnotationStyle Style
+}
+
+// columnColor returns the color of a column with the given value.
+func (self *Sparkline) columnColor(value float64) Color {
+	return ThresholdColor(self.Thresholds, value, self.LineColor)
 }
 
 // SparklineGroup is a renderable widget which groups together the given sparklines.
 type SparklineGroup struct {
 	Block
 	Sparklines []*Sparkline
+
+	// SharedScale scales all sparklines without a MaxVal to the highest value of the group,
+	// so that they can be compared.
+	SharedScale bool
 }
 
 // NewSparkline returns a unrenderable single sparkline that needs to be added to a SparklineGroup
 func NewSparkline() *Sparkline {
 	return &Sparkline{
-		TitleStyle: Theme.Sparkline.Title,
-		LineColor:  Theme.Sparkline.Line,
+		TitleStyle:      Theme.Sparkline.Title,
+		LineColor:       Theme.Sparkline.Line,
+		NumFormatter:    AbbreviateNumber,
+		AnnotationStyle: Theme.Sparkline.Annotation,
 	}
 }
 
@@ -46,6 +83,13 @@ func (self *SparklineGroup) Draw(buf *Buffer) {
 
 	sparklineHeight := self.Inner.Dy() / len(self.Sparklines)
 
+	groupMaxVal := math.Inf(-1)
+	for _, sl := range self.Sparklines {
+		if max, err := GetMaxFloat64FromSlice(sl.Data); err == nil {
+			groupMaxVal = MaxFloat64(groupMaxVal, max)
+		}
+	}
+
 	for i, sl := range self.Sparklines {
 		heightOffset := (sparklineHeight * (i + 1))
 		barHeight := sparklineHeight
@@ -58,37 +102,102 @@ func (self *SparklineGroup) Draw(buf *Buffer) {
 		}
 
 		maxVal := sl.MaxVal
-		if maxVal == 0 {
+		if maxVal == 0 && self.SharedScale {
+			maxVal = groupMaxVal
+		} else if maxVal == 0 {
 			maxVal, _ = GetMaxFloat64FromSlice(sl.Data)
 		}
 
 		// draw line
-		for j := 0; j < len(sl.Data) && j < self.Inner.Dx(); j++ {
+		if sl.Braille {
+			sl.drawBraille(buf, image.Rect(self.Inner.Min.X, self.Inner.Min.Y+heightOffset-barHeight, self.Inner.Max.X, self.Inner.Min.Y+heightOffset), maxVal)
+		}
+		for j := 0; j < len(sl.Data) && j < self.Inner.Dx() && !sl.Braille; j++ {
 			data := sl.Data[j]
-			height := int((data / maxVal) * float64(barHeight))
+			height := int(sl.scale(data, maxVal) * float64(barHeight))
+			color := sl.columnColor(data)
 			sparkChar := BARS[len(BARS)-1]
 			for k := 0; k < height; k++ {
 				buf.SetCell(
-					NewCell(sparkChar, NewStyle(sl.LineColor)),
+					NewCell(sparkChar, NewStyle(color)),
 					image.Pt(j+self.Inner.Min.X, self.Inner.Min.Y-1+heightOffset-k),
 				)
 			}
 			if height == 0 {
 				sparkChar = BARS[1]
 				buf.SetCell(
-					NewCell(sparkChar, NewStyle(sl.LineColor)),
+					NewCell(sparkChar, NewStyle(color)),
 					image.Pt(j+self.Inner.Min.X, self.Inner.Min.Y-1+heightOffset),
 				)
 			}
 		}
 
+		top := self.Inner.Min.Y + heightOffset - barHeight
 		if sl.Title != "" {
+			top--
 			// draw title
 			buf.SetString(
 				TrimString(sl.Title, self.Inner.Dx()),
 				sl.TitleStyle,
-				image.Pt(self.Inner.Min.X, self.Inner.Min.Y-1+heightOffset-barHeight),
+				image.Pt(self.Inner.Min.X, top),
+			)
+		}
+
+		if annotations := sl.annotations(); annotations != "" {
+			annotations = TrimString(annotations, self.Inner.Dx())
+			buf.SetString(
+				annotations,
+				sl.AnnotationStyle,
+				image.Pt(self.Inner.Max.X-rw.StringWidth(annotations), top),
 			)
 		}
 	}
 }
+
+// scale returns the height of a value relative to the range from MinVal to maxVal,
+// between 0 and 1.
+func (self *Sparkline) scale(value, maxVal float64) float64 {
+	if maxVal <= self.MinVal {
+		return 0
+	}
+	return MaxFloat64(MinFloat64((value-self.MinVal)/(maxVal-self.MinVal), 1), 0)
+}
+
+// drawBraille draws the sparkline into area with one column of braille dots per value.
+func (self *Sparkline) drawBraille(buf *Buffer, area image.Rectangle, maxVal float64) {
+	canvas := drawille.NewCanvas()
+	bottom := area.Max.Y*4 - 1
+	for j := 0; j < len(self.Data) && j < area.Dx()*2; j++ {
+		data := self.Data[j]
+		// at least the lowest dot is set, like the lowest bar of the block characters
+		height := MaxInt(int(self.scale(data, maxVal)*float64(area.Dy()*4)), 1)
+		for k := 0; k < height; k++ {
+			canvas.SetPoint(image.Pt(area.Min.X*2+j, bottom-k), drawille.Color(self.columnColor(data)))
+		}
+	}
+	for point, cell := range canvas.GetCells() {
+		if point.In(area) {
+			buf.SetCell(NewCell(cell.Rune, NewStyle(Color(cell.Color))), point)
+		}
+	}
+}
+
+// annotations returns the text of the enabled annotations.
+func (self *Sparkline) annotations() string {
+	if len(self.Data) == 0 {
+		return ""
+	}
+	parts := []string{}
+	if self.ShowMin {
+		min, _ := GetMinFloat64FromSlice(self.Data)
+		parts = append(parts, "min "+self.NumFormatter(min))
+	}
+	if self.ShowMax {
+		max, _ := GetMaxFloat64FromSlice(self.Data)
+		parts = append(parts, "max "+self.NumFormatter(max))
+	}
+	if self.ShowLast {
+		parts = append(parts, self.NumFormatter(self.Data[len(self.Data)-1]))
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,165 @@
+package widgets
+
+import (
+	"fmt"
+	"image"
+	"math"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// bigDigits is a font with 3 lines per character. Characters that aren't part of it,
+// e.g. units, are drawn in normal size on the last line.
+var bigDigits = map[rune][3]string{
+	'0': {"█▀█", "█ █", "▀▀▀"},
+	'1': {"▀█ ", " █ ", "▀▀▀"},
+	'2': {"▀▀█", "█▀▀", "▀▀▀"},
+	'3': {"▀▀█", " ▀█", "▀▀▀"},
+	'4': {"█ █", "▀▀█", "  ▀"},
+	'5': {"█▀▀", "▀▀█", "▀▀▀"},
+	'6': {"█▀▀", "█▀█", "▀▀▀"},
+	'7': {"▀▀█", "  █", "  ▀"},
+	'8': {"█▀█", "█▀█", "▀▀▀"},
+	'9': {"█▀█", "▀▀█", "▀▀▀"},
+	'-': {"   ", "▀▀▀", "   "},
+	'+': {" ▄ ", "▀█▀", "   "},
+	'.': {" ", " ", "▀"},
+	',': {" ", " ", "▀"},
+	':': {" ", "▀", "▀"},
+	'%': {"▀ █", " █ ", "█ ▄"},
+	' ': {" ", " ", " "},
+}
+
+const bigDigitsHeight = 3
+
+// StatPanel is a dashboard tile showing a Value in big digits together with its change
+// since the previous value and a sparkline of the recent History in the background.
+type StatPanel struct {
+	Block
+	Value          float64
+	ValueFormatter func(float64) string
+	ValueStyle     Style
+
+	// Delta is the change of the Value, which is shown with an arrow below the value in
+	// IncreaseStyle or DecreaseStyle. DeltaFormatter formats its absolute value.
+	Delta          float64
+	ShowDelta      bool
+	DeltaFormatter func(float64) string
+	IncreaseStyle  Style
+	DecreaseStyle  Style
+
+	// History is drawn as a sparkline behind the value, with the latest value on the right.
+	// MaxHistory is the number of values Push keeps, older values are dropped.
+	History        []float64
+	MaxHistory     int
+	SparklineColor Color
+}
+
+func NewStatPanel() *StatPanel {
+	return &StatPanel{
+		Block:          *NewBlock(),
+		ValueFormatter: func(v float64) string { return fmt.Sprintf("%.0f", v) },
+		ValueStyle:     Theme.StatPanel.Value,
+		ShowDelta:      true,
+		DeltaFormatter: func(v float64) string { return fmt.Sprintf("%.2f", v) },
+		IncreaseStyle:  Theme.StatPanel.Increase,
+		DecreaseStyle:  Theme.StatPanel.Decrease,
+		SparklineColor: Theme.StatPanel.Sparkline,
+		MaxHistory:     1000,
+	}
+}
+
+// Push sets a new Value, updates the Delta and appends the value to the History,
+// keeping the last MaxHistory values. Only as many values as the widget is wide are drawn.
+func (self *StatPanel) Push(v float64) {
+	if len(self.History) > 0 {
+		self.Delta = v - self.Value
+	}
+	self.Value = v
+	self.History = append(self.History, v)
+	if self.MaxHistory > 0 && len(self.History) > self.MaxHistory {
+		self.History = self.History[len(self.History)-self.MaxHistory:]
+	}
+}
+
+// bigText returns the lines of s in the big digits font.
+func bigText(s string) [bigDigitsHeight]string {
+	var lines [bigDigitsHeight]string
+	for _, r := range s {
+		glyph, ok := bigDigits[r]
+		if !ok {
+			glyph = [3]string{" ", " ", string(r)}
+		}
+		for i := range lines {
+			lines[i] += glyph[i]
+		}
+	}
+	return lines
+}
+
+func (self *StatPanel) drawSparkline(buf *Buffer) {
+	data := self.History
+	if len(data) > self.Inner.Dx() {
+		data = data[len(data)-self.Inner.Dx():]
+	}
+	maxVal, _ := GetMaxFloat64FromSlice(data)
+	if maxVal <= 0 {
+		return
+	}
+	x := self.Inner.Max.X - len(data)
+	for i, v := range data {
+		// the height in eighths of a cell
+		eighths := int(math.Round(MaxFloat64(v, 0) / maxVal * float64(self.Inner.Dy()*8)))
+		for y := self.Inner.Max.Y - 1; eighths > 0 && y >= self.Inner.Min.Y; y-- {
+			bar := BARS[MinInt(eighths, 8)]
+			buf.SetCell(NewCell(bar, NewStyle(self.SparklineColor)), image.Pt(x+i, y))
+			eighths -= 8
+		}
+	}
+}
+
+func (self *StatPanel) Draw(buf *Buffer) {
+	self.Block.Draw(buf)
+	self.drawSparkline(buf)
+
+	value := self.ValueFormatter(self.Value)
+	delta := ""
+	deltaStyle := self.IncreaseStyle
+	if self.ShowDelta {
+		arrow := UP_ARROW
+		if self.Delta < 0 {
+			arrow = DOWN_ARROW
+			deltaStyle = self.DecreaseStyle
+		}
+		delta = fmt.Sprintf("%c %s", arrow, self.DeltaFormatter(math.Abs(self.Delta)))
+	}
+
+	lines := []string{value}
+	big := bigText(value)
+	if rw.StringWidth(big[0]) <= self.Inner.Dx() && self.Inner.Dy() >= bigDigitsHeight {
+		lines = big[:]
+	}
+	height := len(lines)
+	if delta != "" && self.Inner.Dy() > height {
+		height++
+	}
+	y := self.Inner.Min.Y + (self.Inner.Dy()-height)/2
+	for _, line := range lines {
+		// big digits only fit as a whole, the plain value is trimmed like the delta
+		line = TrimString(line, self.Inner.Dx())
+		x := MaxInt(self.Inner.Min.X+(self.Inner.Dx()-rw.StringWidth(line))/2, self.Inner.Min.X)
+		for _, r := range line {
+			// blanks of the big digits leave the sparkline visible
+			if r != ' ' {
+				buf.SetCell(NewCell(r, self.ValueStyle), image.Pt(x, y))
+			}
+			x += rw.RuneWidth(r)
+		}
+		y++
+	}
+	if delta != "" && y < self.Inner.Max.Y {
+		delta = TrimString(delta, self.Inner.Dx())
+		buf.SetString(delta, deltaStyle, image.Pt(self.Inner.Min.X+(self.Inner.Dx()-rw.StringWidth(delta))/2, y))
+	}
+}
@@ -0,0 +1,204 @@
+package widgets
+
+import (
+	"image"
+	"time"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// StatusSegment is a piece of text of a StatusBar.
+type StatusSegment struct {
+	Text string
+	// Style overrides the TextStyle of the StatusBar if set.
+	Style *Style
+	// Alignment is the part of the StatusBar the segment is shown in. Segments with the
+	// same Alignment are shown in order, separated by the Separator.
+	Alignment Alignment
+	// Priority decides which segments are shortened and then hidden first when the
+	// StatusBar is too narrow: those with the lowest Priority, and the last of them if
+	// several have the same. They are shortened down to MinWidth before they are hidden.
+	Priority int
+	MinWidth int
+}
+
+// StatusBar is a single line of left, center and right aligned StatusSegments. While a
+// message shown with ShowMessage hasn't expired, it replaces the left aligned segments.
+type StatusBar struct {
+	Block
+	Segments       []StatusSegment
+	TextStyle      Style
+	Separator      string
+	SeparatorStyle Style
+
+	message        string
+	messageStyle   Style
+	messageExpires time.Time
+}
+
+func NewStatusBar(segments ...StatusSegment) *StatusBar {
+	self := &StatusBar{
+		Block:          *NewBlock(),
+		Segments:       segments,
+		TextStyle:      Theme.StatusBar.Text,
+		Separator:      " " + string(VERTICAL_LINE) + " ",
+		SeparatorStyle: Theme.StatusBar.Separator,
+	}
+	self.Border = false
+	return self
+}
+
+// SetText changes the Text of the segment at index.
+func (self *StatusBar) SetText(index int, text string) {
+	if index >= 0 && index < len(self.Segments) {
+		self.Segments[index].Text = text
+	}
+}
+
+// ShowMessage shows text in the style of the severity instead of the left aligned segments
+// for the given duration, or until the next message. A duration of 0 shows it until
+// ClearMessage is called. The StatusBar has to be rendered again for an expired message
+// to disappear, e.g. when the returned channel receives.
+func (self *StatusBar) ShowMessage(text string, severity Severity, duration time.Duration) <-chan time.Time {
+	self.message = text
+	self.messageStyle = severity.Style()
+	if self.messageStyle.Bg == ColorClear {
+		self.messageStyle.Bg = self.TextStyle.Bg
+	}
+	self.messageExpires = time.Time{}
+	if duration > 0 {
+		self.messageExpires = DefaultClock.Now().Add(duration)
+		return DefaultClock.After(duration)
+	}
+	return nil
+}
+
+// ClearMessage hides the message.
+func (self *StatusBar) ClearMessage() {
+	self.message = ""
+}
+
+// Message returns the message that is shown, or "" if there is none or it expired.
+func (self *StatusBar) Message() string {
+	if !self.messageExpires.IsZero() && !DefaultClock.Now().Before(self.messageExpires) {
+		self.message = ""
+	}
+	return self.message
+}
+
+// visibleSegments returns the segments that are shown, with the message replacing the
+// left aligned ones.
+func (self *StatusBar) visibleSegments() []StatusSegment {
+	message := self.Message()
+	if message == "" {
+		return self.Segments
+	}
+	style := self.messageStyle
+	segments := []StatusSegment{{Text: message, Style: &style, Priority: int(^uint(0) >> 1)}}
+	for _, segment := range self.Segments {
+		if segment.Alignment != AlignLeft {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+// segmentWidths returns the widths the segments are drawn with in width cells, 0 for
+// hidden segments.
+func (self *StatusBar) segmentWidths(segments []StatusSegment, width int) []int {
+	widths := make([]int, len(segments))
+	for i, segment := range segments {
+		widths[i] = rw.StringWidth(segment.Text)
+	}
+	separator := rw.StringWidth(self.Separator)
+	total := func() int {
+		sum := 0
+		shown := map[Alignment]int{}
+		for i, segment := range segments {
+			if widths[i] > 0 {
+				sum += widths[i]
+				shown[segment.Alignment]++
+			}
+		}
+		for _, count := range shown {
+			sum += (count - 1) * separator
+		}
+		// the groups are kept apart by a cell
+		return sum + len(shown) - 1
+	}
+	for overflow := total() - width; overflow > 0; overflow = total() - width {
+		victim := -1
+		for i, segment := range segments {
+			if widths[i] > 0 && (victim < 0 || segment.Priority <= segments[victim].Priority) {
+				victim = i
+			}
+		}
+		if victim < 0 {
+			break
+		}
+		if minWidth := segments[victim].MinWidth; widths[victim] > minWidth && minWidth > 0 {
+			widths[victim] = MaxInt(widths[victim]-overflow, minWidth)
+		} else {
+			widths[victim] = 0
+		}
+	}
+	return widths
+}
+
+// drawGroup draws the shown segments with the given alignment from x and returns the x
+// after them.
+func (self *StatusBar) drawGroup(buf *Buffer, segments []StatusSegment, widths []int, alignment Alignment, x int) int {
+	first := true
+	for i, segment := range segments {
+		if segment.Alignment != alignment || widths[i] == 0 {
+			continue
+		}
+		if !first {
+			buf.SetString(self.Separator, self.SeparatorStyle, image.Pt(x, self.Inner.Min.Y))
+			x += rw.StringWidth(self.Separator)
+		}
+		first = false
+		style := self.TextStyle
+		if segment.Style != nil {
+			style = *segment.Style
+		}
+		buf.SetString(TrimString(segment.Text, widths[i]), style, image.Pt(x, self.Inner.Min.Y))
+		x += widths[i]
+	}
+	return x
+}
+
+// groupWidth returns the width of the shown segments with the given alignment.
+func (self *StatusBar) groupWidth(segments []StatusSegment, widths []int, alignment Alignment) int {
+	width, count := 0, 0
+	for i, segment := range segments {
+		if segment.Alignment == alignment && widths[i] > 0 {
+			width += widths[i]
+			count++
+		}
+	}
+	return width + MaxInt(count-1, 0)*rw.StringWidth(self.Separator)
+}
+
+func (self *StatusBar) Draw(buf *Buffer) {
+	self.Block.Draw(buf)
+
+	row := image.Rect(self.Inner.Min.X, self.Inner.Min.Y, self.Inner.Max.X, self.Inner.Min.Y+1)
+	buf.Fill(NewCell(' ', self.TextStyle), row)
+
+	segments := self.visibleSegments()
+	widths := self.segmentWidths(segments, row.Dx())
+	left := self.drawGroup(buf, segments, widths, AlignLeft, row.Min.X)
+	rightWidth := self.groupWidth(segments, widths, AlignRight)
+	self.drawGroup(buf, segments, widths, AlignRight, row.Max.X-rightWidth)
+
+	// the center group stays between the others
+	centerWidth := self.groupWidth(segments, widths, AlignCenter)
+	x := row.Min.X + (row.Dx()-centerWidth)/2
+	x = MinInt(x, row.Max.X-rightWidth-1-centerWidth)
+	if left > row.Min.X {
+		x = MaxInt(x, left+1)
+	}
+	self.drawGroup(buf, segments, widths, AlignCenter, x)
+}
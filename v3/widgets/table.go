@@ -5,8 +5,11 @@
 package widgets
 
 import (
+	"fmt"
 	"image"
+	"strings"
 
+	rw "github.com/mattn/go-runewidth"
 	. "github.com/s-westphal/termui/v3"
 )
 
@@ -29,6 +32,81 @@ type Table struct {
 	RowStyles     map[int]Style
 	FillRow       bool
 
+	// DataProvider replaces Rows if set. Sorting and editing only work with Rows.
+	DataProvider TableDataProvider
+
+	// ColumnAlignments overrides the TextAlignment of individual columns.
+	ColumnAlignments map[int]Alignment
+
+	// CellStyles overrides the row style of individual cells.
+	// Cells are addressed by image.Pt(column, row).
+	CellStyles map[image.Point]Style
+	// CellSeverity is used for conditional formatting: cells of rows other than the header,
+	// which have no CellStyles entry, are drawn in the style of the returned severity if ok.
+	CellSeverity func(row, column int, text string) (severity Severity, ok bool)
+	// CellSpans lets cells span multiple columns and rows, e.g. for grouped headers or summary rows.
+	// The text of the top left cell, given as image.Pt(column, row), is drawn across the span
+	// and the cells it covers are ignored.
+	CellSpans map[image.Point]CellSpan
+
+	// SortColumn and SortOrder describe how the rows are currently sorted, see SortBy.
+	SortColumn int
+	SortOrder  SortOrder
+	// ColumnComparators can be used to customize the sorting of a column.
+	ColumnComparators map[int]TableComparator
+	// LabelShortener is used to shorten header labels that don't fit their column.
+	LabelShortener LabelShortener
+
+	// Selectable enables a cursor row, which is moved by the scroll methods instead of the view.
+	// SelectedRow is the index of the cursor row in Rows and is never the header.
+	Selectable       bool
+	SelectedRow      int
+	SelectedRowStyle Style
+	// OnActivate is called with the SelectedRow by Activate, e.g. when Enter is pressed.
+	OnActivate func(row int)
+
+	// Editable allows editing the SelectedColumn of the SelectedRow, see StartEdit.
+	Editable       bool
+	SelectedColumn int
+	EditStyle      Style
+	EditErrorStyle Style
+	// EditValidator is called before an edit is committed. If it returns an error,
+	// the error is shown and the cell stays in edit mode.
+	EditValidator func(row, column int, value string) error
+	// OnEdit is called after an edit was committed.
+	OnEdit func(row, column int, value string)
+
+	editing    bool
+	editRow    int
+	editColumn int
+	editText   []rune
+	editCursor int
+	editError  error
+
+	// PageSize enables pagination as an alternative to scrolling if it is > 0.
+	// The rows below the header are shown PageSize at a time, starting with Page.
+	PageSize int
+	Page     int
+
+	// Filter hides the rows below the header for which it returns false, without removing them from Rows.
+	Filter func(row []string) bool
+	// SearchText hides the rows below the header that don't contain it, ignoring case,
+	// and highlights the matches with SearchStyle.
+	SearchText  string
+	SearchStyle Style
+
+	// FrozenColumns is the number of leading columns that stay in place while
+	// scrolling horizontally. They are separated from the others by FrozenSeparatorStyle.
+	FrozenColumns        int
+	FrozenSeparatorStyle Style
+
+	// leftColumn is the number of columns after the frozen ones that are scrolled out of view.
+	leftColumn int
+	// topRow is the number of visible rows below the header that are scrolled out of view.
+	topRow int
+	// selectionRect is the area of the selected row as of the last Draw.
+	selectionRect image.Rectangle
+
 	// ColumnResizer is called on each Draw. Can be used for custom column sizing.
 	ColumnResizer func()
 }
@@ -40,97 +118,416 @@ func NewTable() *Table {
 		RowSeparator:  true,
 		RowStyles:     make(map[int]Style),
 		ColumnResizer: func() {},
+
+		SelectedRow:      1,
+		SelectedRowStyle: Theme.Table.Selected,
+		EditStyle:        Theme.Table.Edit,
+		EditErrorStyle:   Theme.Table.Error,
+		SearchStyle:      Theme.Table.Match,
+
+		FrozenSeparatorStyle: Theme.Table.FrozenSeparator,
+		CellStyles:           make(map[image.Point]Style),
+		CellSpans:            make(map[image.Point]CellSpan),
+		ColumnAlignments:     make(map[int]Alignment),
+		ColumnComparators:    make(map[int]TableComparator),
 	}
 }
 
 func (self *Table) Draw(buf *Buffer) {
 	self.Block.Draw(buf)
+	self.selectionRect = image.Rectangle{}
 
 	self.ColumnResizer()
 
+	if self.rowCount() == 0 {
+		return
+	}
+
 	columnWidths := self.ColumnWidths
 	if len(columnWidths) == 0 {
-		columnCount := len(self.Rows[0])
+		columnCount := len(self.row(0))
 		columnWidth := self.Inner.Dx() / columnCount
 		for i := 0; i < columnCount; i++ {
 			columnWidths = append(columnWidths, columnWidth)
 		}
 	}
 
+	columns := self.visibleColumns(columnWidths)
+
+	rows := self.visibleRows()
+	lastRow := rows.Len()
+	if self.PageSize > 0 {
+		// the page follows the selected row
+		if self.Selectable && rows.Len() > 0 {
+			position := self.selectedPosition(rows)
+			self.SelectedRow = rows.At(position)
+			self.Page = position / self.PageSize
+		}
+		self.Page = MaxInt(MinInt(self.Page, self.PageCount()-1), 0)
+		self.topRow = self.Page * self.PageSize
+		lastRow = MinInt(lastRow, self.topRow+self.PageSize)
+	} else {
+		// adjusts view to the selected row
+		if self.Selectable && rows.Len() > 0 {
+			position := self.selectedPosition(rows)
+			self.SelectedRow = rows.At(position)
+			if position >= self.visibleRowCount()+self.topRow {
+				self.topRow = position - self.visibleRowCount() + 1
+			} else if position < self.topRow {
+				self.topRow = position
+			}
+		}
+
+		// clamp the scroll position in case rows were removed or the widget was resized
+		self.topRow = MaxInt(MinInt(self.topRow, self.maxTopRow()), 0)
+	}
+
 	yCoordinate := self.Inner.Min.Y
 
+	// draw header, which stays pinned while scrolling
+	next := -1
+	if self.topRow < lastRow {
+		next = rows.At(self.topRow)
+	}
+	yCoordinate = self.drawRow(buf, 0, next, yCoordinate, columnWidths, columns)
+
 	// draw rows
-	for i := 0; i < len(self.Rows) && yCoordinate < self.Inner.Max.Y; i++ {
-		row := self.Rows[i]
-		colXCoordinate := self.Inner.Min.X
-
-		rowStyle := self.TextStyle
-		// get the row style if one exists
-		if style, ok := self.RowStyles[i]; ok {
-			rowStyle = style
-		}
-
-		if self.FillRow {
-			blankCell := NewCell(' ', rowStyle)
-			buf.Fill(blankCell, image.Rect(self.Inner.Min.X, yCoordinate, self.Inner.Max.X, yCoordinate+1))
-		}
-
-		// draw row cells
-		for j := 0; j < len(row); j++ {
-			col := ParseStyles(row[j], rowStyle)
-			// draw row cell
-			if len(col) > columnWidths[j] || self.TextAlignment == AlignLeft {
-				for _, cx := range BuildCellWithXArray(col) {
-					k, cell := cx.X, cx.Cell
-					if k == columnWidths[j] || colXCoordinate+k == self.Inner.Max.X {
-						cell.Rune = ELLIPSES
-						buf.SetCell(cell, image.Pt(colXCoordinate+k-1, yCoordinate))
-						break
-					} else {
-						buf.SetCell(cell, image.Pt(colXCoordinate+k, yCoordinate))
-					}
-				}
-			} else if self.TextAlignment == AlignCenter {
-				xCoordinateOffset := (columnWidths[j] - len(col)) / 2
-				stringXCoordinate := xCoordinateOffset + colXCoordinate
-				for _, cx := range BuildCellWithXArray(col) {
-					k, cell := cx.X, cx.Cell
-					buf.SetCell(cell, image.Pt(stringXCoordinate+k, yCoordinate))
-				}
-			} else if self.TextAlignment == AlignRight {
-				stringXCoordinate := MinInt(colXCoordinate+columnWidths[j], self.Inner.Max.X) - len(col)
-				for _, cx := range BuildCellWithXArray(col) {
-					k, cell := cx.X, cx.Cell
-					buf.SetCell(cell, image.Pt(stringXCoordinate+k, yCoordinate))
-				}
+	editYCoordinate := -1
+	for k := self.topRow; k < lastRow && yCoordinate < self.Inner.Max.Y; k++ {
+		i := rows.At(k)
+		if self.editing && i == self.editRow {
+			editYCoordinate = yCoordinate
+		}
+		next := -1
+		if k+1 < rows.Len() {
+			next = rows.At(k + 1)
+		}
+		yCoordinate = self.drawRow(buf, i, next, yCoordinate, columnWidths, columns)
+	}
+
+	if editYCoordinate >= 0 {
+		self.drawEditor(buf, editYCoordinate, columnWidths, columns)
+	}
+
+	if self.PageSize > 0 {
+		self.drawPageFooter(buf)
+		return
+	}
+
+	// draw UP_ARROW if needed
+	if self.topRow > 0 {
+		buf.SetCell(
+			NewCell(UP_ARROW, NewStyle(ColorWhite)),
+			image.Pt(self.Inner.Max.X-1, self.Inner.Min.Y+self.rowHeight()),
+		)
+	}
+
+	// draw DOWN_ARROW if needed
+	if self.topRow < self.maxTopRow() {
+		buf.SetCell(
+			NewCell(DOWN_ARROW, NewStyle(ColorWhite)),
+			image.Pt(self.Inner.Max.X-1, self.Inner.Max.Y-1),
+		)
+	}
+}
+
+// drawPageFooter draws "Page X of Y" right aligned into the bottom border,
+// or into the last line if there is no bottom border.
+func (self *Table) drawPageFooter(buf *Buffer) {
+	footer := fmt.Sprintf(" Page %d of %d ", self.Page+1, self.PageCount())
+	y := self.Max.Y - 1
+	if !self.Border || !self.BorderBottom {
+		y = self.Inner.Max.Y - 1
+		buf.Fill(NewCell(' ', self.TextStyle), image.Rect(self.Inner.Min.X, y, self.Inner.Max.X, y+1))
+	}
+	buf.SetString(
+		footer,
+		self.TextStyle,
+		image.Pt(MaxInt(self.Inner.Max.X-rw.StringWidth(footer), self.Inner.Min.X), y),
+	)
+}
+
+// drawRow draws the row with the given index and its separators at yCoordinate,
+// and returns the yCoordinate of the next row. next is the index of the row drawn
+// below it, or -1 if there is none and no horizontal separator should be drawn.
+func (self *Table) drawRow(buf *Buffer, i int, next int, yCoordinate int, columnWidths []int, columns []int) int {
+	row := self.row(i)
+	cells := self.rowCells(i, columns, columnWidths)
+
+	rowStyle := self.TextStyle
+	// get the row style if one exists
+	if style, ok := self.RowStyles[i]; ok {
+		rowStyle = style
+	}
+	selected := self.Selectable && i == self.SelectedRow
+	if selected {
+		rowStyle = self.SelectedRowStyle
+		self.selectionRect = image.Rect(self.Inner.Min.X, yCoordinate, self.Inner.Max.X, yCoordinate+1)
+	}
+
+	if self.FillRow || selected {
+		blankCell := NewCell(' ', rowStyle)
+		buf.Fill(blankCell, image.Rect(self.Inner.Min.X, yCoordinate, self.Inner.Max.X, yCoordinate+1))
+	}
+
+	// draw row cells
+	for _, tc := range cells {
+		j, colXCoordinate, width := tc.column, tc.x, tc.width
+		// cells covered by a span are left empty, as is a span whose first column is scrolled out of view
+		if tc.origin != image.Pt(j, i) {
+			continue
+		}
+		if j >= len(row) {
+			break
+		}
+		if i == 0 {
+			buf.AddHitRegion(self, "header", image.Rect(colXCoordinate, yCoordinate, MinInt(colXCoordinate+width, self.Inner.Max.X), yCoordinate+1), j)
+		}
+		cellStyle := rowStyle
+		// get the cell style if one exists
+		style, ok := self.CellStyles[image.Pt(j, i)]
+		if !ok && i != 0 && self.CellSeverity != nil {
+			var severity Severity
+			if severity, ok = self.CellSeverity(i, j, row[j]); ok {
+				style = severity.Style()
+			}
+		}
+		if ok && !selected {
+			cellStyle = style
+			if self.FillRow {
+				blankCell := NewCell(' ', cellStyle)
+				buf.Fill(blankCell, image.Rect(colXCoordinate, yCoordinate, MinInt(colXCoordinate+width, self.Inner.Max.X), yCoordinate+1))
 			}
-			colXCoordinate += columnWidths[j] + 1
 		}
 
-		// draw vertical separators
-		separatorStyle := self.Block.BorderStyle
+		if selected && self.Editable && j == self.SelectedColumn {
+			cellStyle.Modifier |= ModifierUnderline
+		}
 
-		separatorXCoordinate := self.Inner.Min.X
-		verticalCell := NewCell(VERTICAL_LINE, separatorStyle)
-		for i, width := range columnWidths {
-			if self.FillRow && i < len(columnWidths)-1 {
-				verticalCell.Style.Bg = rowStyle.Bg
-			} else {
-				verticalCell.Style.Bg = self.Block.BorderStyle.Bg
+		col := ParseStyles(row[j], cellStyle)
+		if i != 0 && self.SearchText != "" {
+			col = self.highlightMatches(col)
+		}
+		sorted := i == 0 && j == self.SortColumn && self.SortOrder != SortNone
+		// shorten header labels that don't fit
+		if i == 0 && self.LabelShortener != nil && len(col) > 0 {
+			labelWidth := width
+			if sorted {
+				labelWidth -= 2
+			}
+			if label := CellsToString(col); rw.StringWidth(label) > labelWidth {
+				col = RunesToStyledCells([]rune(self.LabelShortener(label, labelWidth)), col[0].Style)
+			}
+		}
+		// draw sort indicator in the header
+		if sorted {
+			indicator := UP_ARROW
+			if self.SortOrder == SortDescending {
+				indicator = DOWN_ARROW
+			}
+			col = append(col, NewCell(' ', cellStyle), NewCell(indicator, cellStyle))
+		}
+		alignment := self.TextAlignment
+		if a, ok := self.ColumnAlignments[j]; ok {
+			alignment = a
+		}
+		// draw row cell
+		if len(col) > width || alignment == AlignLeft || alignment == AlignJustify {
+			for _, cx := range BuildCellWithXArray(col) {
+				k, cell := cx.X, cx.Cell
+				if k == width || colXCoordinate+k == self.Inner.Max.X {
+					cell.Rune = ELLIPSES
+					buf.SetCell(cell, image.Pt(colXCoordinate+k-1, yCoordinate))
+					break
+				} else {
+					buf.SetCell(cell, image.Pt(colXCoordinate+k, yCoordinate))
+				}
 			}
+		} else if alignment == AlignCenter {
+			xCoordinateOffset := (width - len(col)) / 2
+			stringXCoordinate := xCoordinateOffset + colXCoordinate
+			for _, cx := range BuildCellWithXArray(col) {
+				k, cell := cx.X, cx.Cell
+				buf.SetCell(cell, image.Pt(stringXCoordinate+k, yCoordinate))
+			}
+		} else if alignment == AlignRight {
+			stringXCoordinate := MinInt(colXCoordinate+width, self.Inner.Max.X) - len(col)
+			for _, cx := range BuildCellWithXArray(col) {
+				k, cell := cx.X, cx.Cell
+				buf.SetCell(cell, image.Pt(stringXCoordinate+k, yCoordinate))
+			}
+		}
+		buf.SetLinks(ParseStyleLinks(row[j]), image.Rect(colXCoordinate, yCoordinate, MinInt(colXCoordinate+width, self.Inner.Max.X), yCoordinate+1))
+	}
 
-			separatorXCoordinate += width
-			buf.SetCell(verticalCell, image.Pt(separatorXCoordinate, yCoordinate))
-			separatorXCoordinate++
+	if i != 0 {
+		buf.AddHitRegion(self, "row", image.Rect(self.Inner.Min.X, yCoordinate, self.Inner.Max.X, yCoordinate+1), i)
+	}
+
+	// draw vertical separators
+	separatorStyle := self.Block.BorderStyle
+
+	for k, tc := range cells {
+		verticalCell := NewCell(VERTICAL_LINE, separatorStyle)
+		if tc.last == self.FrozenColumns-1 {
+			verticalCell.Style = self.FrozenSeparatorStyle
 		}
+		if self.FillRow && k < len(cells)-1 {
+			verticalCell.Style.Bg = rowStyle.Bg
+		} else {
+			verticalCell.Style.Bg = self.Block.BorderStyle.Bg
+		}
+
+		buf.SetCell(verticalCell, image.Pt(tc.x+tc.width, yCoordinate))
+	}
+
+	yCoordinate++
 
+	// draw horizontal separator
+	if self.RowSeparator && yCoordinate < self.Inner.Max.Y && next >= 0 {
+		self.drawRowSeparator(buf, cells, next, yCoordinate)
 		yCoordinate++
+	}
+
+	return yCoordinate
+}
+
+// rowHeight returns the number of lines taken up by a row and its separator.
+func (self *Table) rowHeight() int {
+	if self.RowSeparator {
+		return 2
+	}
+	return 1
+}
+
+// visibleRowCount returns the number of rows that fit below the header.
+func (self *Table) visibleRowCount() int {
+	height := self.Inner.Dy() - self.rowHeight()
+	return MaxInt((height+self.rowHeight()-1)/self.rowHeight(), 0)
+}
+
+// maxTopRow returns the scroll position at which the last row is visible.
+func (self *Table) maxTopRow() int {
+	return MaxInt(self.visibleRows().Len()-self.visibleRowCount(), 0)
+}
+
+// visibleRows returns the rows below the header that pass Filter and SearchText.
+func (self *Table) visibleRows() rowIndex {
+	count := self.rowCount()
+	if self.Filter == nil && self.SearchText == "" {
+		return rowIndex{first: 1, count: count}
+	}
+	rows := make([]int, 0, count)
+	for i := 1; i < count; i++ {
+		if self.matches(self.row(i)) {
+			rows = append(rows, i)
+		}
+	}
+	return rowIndex{indices: rows}
+}
+
+// selectedPosition returns the position of the SelectedRow in rows. If the SelectedRow
+// is hidden, the position of the next visible row is returned instead.
+func (self *Table) selectedPosition(rows rowIndex) int {
+	return rows.Position(self.SelectedRow)
+}
+
+// SelectionRect returns the area of the first line of the SelectedRow as of the last Draw,
+// which is empty if it isn't visible.
+func (self *Table) SelectionRect() image.Rectangle {
+	return self.selectionRect
+}
 
-		// draw horizontal separator
-		horizontalCell := NewCell(HORIZONTAL_LINE, separatorStyle)
-		if self.RowSeparator && yCoordinate < self.Inner.Max.Y && i != len(self.Rows)-1 {
-			buf.Fill(horizontalCell, image.Rect(self.Inner.Min.X, yCoordinate, self.Inner.Max.X, yCoordinate+1))
-			yCoordinate++
+// ScrollAmount scrolls by amount given. If amount is < 0, then scroll up.
+// If the Table is Selectable, the SelectedRow is moved and the view follows it when drawn.
+func (self *Table) ScrollAmount(amount int) {
+	if self.Selectable {
+		rows := self.visibleRows()
+		if rows.Len() > 0 {
+			position := self.selectedPosition(rows) + amount
+			self.SelectedRow = rows.At(MaxInt(MinInt(position, rows.Len()-1), 0))
 		}
+		return
+	}
+	self.topRow = MaxInt(MinInt(self.topRow+amount, self.maxTopRow()), 0)
+}
+
+func (self *Table) ScrollUp() {
+	self.ScrollAmount(-1)
+}
+
+func (self *Table) ScrollDown() {
+	self.ScrollAmount(1)
+}
+
+func (self *Table) ScrollPageUp() {
+	// If a row is selected below top row, then go to the top row.
+	rows := self.visibleRows()
+	if self.Selectable && rows.Len() > 0 && self.selectedPosition(rows) > self.topRow && self.topRow < rows.Len() {
+		self.SelectedRow = rows.At(self.topRow)
+	} else {
+		self.ScrollAmount(-self.visibleRowCount())
+	}
+}
+
+func (self *Table) ScrollPageDown() {
+	self.ScrollAmount(self.visibleRowCount())
+}
+
+func (self *Table) ScrollHalfPageUp() {
+	self.ScrollAmount(-int(FloorFloat64(float64(self.visibleRowCount()) / 2)))
+}
+
+func (self *Table) ScrollHalfPageDown() {
+	self.ScrollAmount(int(FloorFloat64(float64(self.visibleRowCount()) / 2)))
+}
+
+func (self *Table) ScrollTop() {
+	self.topRow = 0
+	self.SelectedRow = 1
+}
+
+func (self *Table) ScrollBottom() {
+	self.topRow = self.maxTopRow()
+	self.SelectedRow = MaxInt(self.rowCount()-1, 1)
+}
+
+// Activate calls OnActivate with the SelectedRow.
+func (self *Table) Activate() {
+	if self.Selectable && self.OnActivate != nil && self.SelectedRow < self.rowCount() {
+		self.OnActivate(self.SelectedRow)
+	}
+}
+
+// PageCount returns the number of pages if pagination is enabled.
+func (self *Table) PageCount() int {
+	if self.PageSize <= 0 {
+		return 1
+	}
+	return MaxInt((self.visibleRows().Len()+self.PageSize-1)/self.PageSize, 1)
+}
+
+// NextPage shows the next page and, if the Table is Selectable, selects its first row.
+func (self *Table) NextPage() {
+	self.setPage(self.Page + 1)
+}
+
+// PrevPage shows the previous page and, if the Table is Selectable, selects its first row.
+func (self *Table) PrevPage() {
+	self.setPage(self.Page - 1)
+}
+
+func (self *Table) setPage(page int) {
+	self.Page = MaxInt(MinInt(page, self.PageCount()-1), 0)
+	if rows := self.visibleRows(); self.Selectable && self.PageSize > 0 && rows.Len() > 0 {
+		self.SelectedRow = rows.At(MinInt(self.Page*self.PageSize, rows.Len()-1))
+	}
+}
+
+// CopySelectedRow copies the cells of the SelectedRow to the clipboard, separated by tabs.
+func (self *Table) CopySelectedRow() error {
+	if !self.Selectable || self.SelectedRow < 1 || self.SelectedRow >= self.rowCount() {
+		return nil
 	}
+	return CopyToClipboard(strings.Join(self.row(self.SelectedRow), "\t"))
 }
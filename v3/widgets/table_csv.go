@@ -0,0 +1,127 @@
+package widgets
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+type CSVHeader uint
+
+const (
+	// CSVHeaderAuto treats the first record as header if it looks like one,
+	// i.e. if it contains text in a column in which all other records are numbers.
+	CSVHeaderAuto CSVHeader = iota
+	CSVHeaderPresent
+	CSVHeaderAbsent
+)
+
+// CSVOptions configures Table.LoadCSV.
+type CSVOptions struct {
+	// Comma is the field delimiter. Defaults to ',', use '\t' for TSV.
+	Comma  rune
+	Header CSVHeader
+	// AlignNumbers right-aligns the columns that only contain numbers.
+	AlignNumbers bool
+}
+
+// LoadCSV replaces the rows of the Table with the records read from r and sizes the columns
+// to fit their content. If the data has no header row, one is generated from the column numbers.
+func (self *Table) LoadCSV(r io.Reader, opts CSVOptions) error {
+	reader := csv.NewReader(r)
+	if opts.Comma != 0 {
+		reader.Comma = opts.Comma
+	}
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		self.Rows = [][]string{}
+		return nil
+	}
+
+	columnCount := 0
+	for _, record := range records {
+		columnCount = MaxInt(columnCount, len(record))
+	}
+	for i, record := range records {
+		for len(record) < columnCount {
+			record = append(record, "")
+		}
+		records[i] = record
+	}
+
+	hasHeader := opts.Header == CSVHeaderPresent
+	if opts.Header == CSVHeaderAuto {
+		hasHeader = csvHasHeader(records)
+	}
+	if !hasHeader {
+		header := make([]string, columnCount)
+		for j := range header {
+			header[j] = fmt.Sprintf("%d", j+1)
+		}
+		records = append([][]string{header}, records...)
+	}
+
+	self.Rows = records
+	self.ColumnWidths = make([]int, columnCount)
+	for _, record := range records {
+		for j, field := range record {
+			self.ColumnWidths[j] = MaxInt(self.ColumnWidths[j], rw.StringWidth(field))
+		}
+	}
+
+	if opts.AlignNumbers {
+		if self.ColumnAlignments == nil {
+			self.ColumnAlignments = make(map[int]Alignment)
+		}
+		for j := 0; j < columnCount; j++ {
+			if csvColumnIsNumeric(records[1:], j) {
+				self.ColumnAlignments[j] = AlignRight
+			}
+		}
+	}
+
+	return nil
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	return err == nil
+}
+
+// csvColumnIsNumeric reports whether all non-empty fields of a column are numbers.
+func csvColumnIsNumeric(records [][]string, column int) bool {
+	numeric := false
+	for _, record := range records {
+		if strings.TrimSpace(record[column]) == "" {
+			continue
+		}
+		if !isNumber(record[column]) {
+			return false
+		}
+		numeric = true
+	}
+	return numeric
+}
+
+// csvHasHeader reports whether the first record looks like a header.
+func csvHasHeader(records [][]string) bool {
+	if len(records) < 2 {
+		return false
+	}
+	for j, field := range records[0] {
+		if !isNumber(field) && strings.TrimSpace(field) != "" && csvColumnIsNumeric(records[1:], j) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package widgets
+
+import (
+	"image"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// SelectPreviousColumn moves the cell cursor of an Editable Table to the left.
+func (self *Table) SelectPreviousColumn() {
+	self.SelectedColumn = MaxInt(self.SelectedColumn-1, 0)
+}
+
+// SelectNextColumn moves the cell cursor of an Editable Table to the right.
+func (self *Table) SelectNextColumn() {
+	if len(self.Rows) > 0 {
+		self.SelectedColumn = MinInt(self.SelectedColumn+1, len(self.Rows[0])-1)
+	}
+}
+
+// IsEditing reports whether a cell is currently being edited.
+func (self *Table) IsEditing() bool {
+	return self.editing
+}
+
+// StartEdit opens an inline text input over the selected cell of an Editable and Selectable Table.
+func (self *Table) StartEdit() {
+	if !self.Editable || !self.Selectable || self.SelectedRow < 1 || self.SelectedRow >= len(self.Rows) {
+		return
+	}
+	row := self.Rows[self.SelectedRow]
+	if self.SelectedColumn < 0 || self.SelectedColumn >= len(row) {
+		return
+	}
+	self.editing = true
+	self.editRow = self.SelectedRow
+	self.editColumn = self.SelectedColumn
+	self.editText = []rune(row[self.SelectedColumn])
+	self.editCursor = len(self.editText)
+	self.editError = nil
+}
+
+// CancelEdit closes the text input and discards the edit.
+func (self *Table) CancelEdit() {
+	self.editing = false
+	self.editError = nil
+}
+
+// CommitEdit validates the edited value with EditValidator and writes it to the cell.
+// If the validation fails, the error is shown, returned and the cell stays in edit mode.
+func (self *Table) CommitEdit() error {
+	if !self.editing {
+		return nil
+	}
+	value := string(self.editText)
+	if self.EditValidator != nil {
+		if err := self.EditValidator(self.editRow, self.editColumn, value); err != nil {
+			self.editError = err
+			return err
+		}
+	}
+	self.Rows[self.editRow][self.editColumn] = value
+	self.editing = false
+	self.editError = nil
+	if self.OnEdit != nil {
+		self.OnEdit(self.editRow, self.editColumn, value)
+	}
+	return nil
+}
+
+// HandleEditEvent passes a keyboard event to the text input while a cell is edited.
+// Enter commits and Escape cancels the edit. It returns true if the event was consumed.
+func (self *Table) HandleEditEvent(e Event) bool {
+	if !self.editing || e.Type != KeyboardEvent {
+		return false
+	}
+	switch e.ID {
+	case "<Enter>":
+		self.CommitEdit()
+	case "<Escape>":
+		self.CancelEdit()
+	case "<Left>":
+		self.editCursor = MaxInt(self.editCursor-1, 0)
+	case "<Right>":
+		self.editCursor = MinInt(self.editCursor+1, len(self.editText))
+	case "<Home>", "<C-a>":
+		self.editCursor = 0
+	case "<End>", "<C-e>":
+		self.editCursor = len(self.editText)
+	case "<Backspace>", "<C-<Backspace>>":
+		if self.editCursor > 0 {
+			self.editText = append(self.editText[:self.editCursor-1], self.editText[self.editCursor:]...)
+			self.editCursor--
+		}
+	case "<Delete>":
+		if self.editCursor < len(self.editText) {
+			self.editText = append(self.editText[:self.editCursor], self.editText[self.editCursor+1:]...)
+		}
+	default:
+		r := []rune(e.ID)
+		if e.ID == "<Space>" {
+			r = []rune{' '}
+		}
+		if len(r) == 1 {
+			self.editText = append(self.editText[:self.editCursor], append(r, self.editText[self.editCursor:]...)...)
+			self.editCursor++
+		}
+	}
+	return true
+}
+
+// drawEditor draws the text input over the edited cell.
+func (self *Table) drawEditor(buf *Buffer, yCoordinate int, columnWidths []int, columns []int) {
+	xCoordinate := self.Inner.Min.X
+	for _, j := range columns {
+		if j == self.editColumn {
+			break
+		}
+		xCoordinate += columnWidths[j] + 1
+	}
+	if self.editColumn >= len(columnWidths) || xCoordinate >= self.Inner.Max.X {
+		return
+	}
+	width := MaxInt(columnWidths[self.editColumn], rw.StringWidth(string(self.editText))+1)
+	rect := image.Rect(xCoordinate, yCoordinate, MinInt(xCoordinate+width, self.Inner.Max.X), yCoordinate+1)
+	buf.Fill(NewCell(' ', self.EditStyle), rect)
+
+	// keep the cursor in view
+	offset := MaxInt(self.editCursor-rect.Dx()+1, 0)
+	x := rect.Min.X
+	for i := offset; i <= len(self.editText) && x < rect.Max.X; i++ {
+		cell := NewCell(' ', self.EditStyle)
+		if i < len(self.editText) {
+			cell.Rune = self.editText[i]
+		}
+		if i == self.editCursor {
+			cell.Style.Modifier |= ModifierReverse
+		}
+		buf.SetCell(cell, image.Pt(x, yCoordinate))
+		x += MaxInt(rw.RuneWidth(cell.Rune), 1)
+	}
+
+	if self.editError != nil && yCoordinate+1 < self.Inner.Max.Y {
+		message := TrimString(self.editError.Error(), self.Inner.Max.X-rect.Min.X)
+		buf.Fill(NewCell(' ', self.EditErrorStyle), image.Rect(rect.Min.X, yCoordinate+1, rect.Min.X+rw.StringWidth(message), yCoordinate+2))
+		buf.SetString(message, self.EditErrorStyle, image.Pt(rect.Min.X, yCoordinate+1))
+	}
+}
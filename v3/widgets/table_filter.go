@@ -0,0 +1,57 @@
+package widgets
+
+import (
+	"unicode"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// matches reports whether a row passes Filter and contains SearchText.
+func (self *Table) matches(row []string) bool {
+	if self.Filter != nil && !self.Filter(row) {
+		return false
+	}
+	if self.SearchText == "" {
+		return true
+	}
+	for _, cell := range row {
+		if len(findMatches(ParseStyles(cell, StyleClear), self.SearchText)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// findMatches returns the indices of the cells at which text starts, ignoring case.
+func findMatches(cells []Cell, text string) []int {
+	needle := []rune(text)
+	matches := []int{}
+	if len(needle) == 0 {
+		return matches
+	}
+	for i := 0; i+len(needle) <= len(cells); i++ {
+		found := true
+		for j, r := range needle {
+			if unicode.ToLower(cells[i+j].Rune) != unicode.ToLower(r) {
+				found = false
+				break
+			}
+		}
+		if found {
+			matches = append(matches, i)
+			i += len(needle) - 1
+		}
+	}
+	return matches
+}
+
+// highlightMatches applies the SearchStyle to all occurrences of SearchText in cells.
+func (self *Table) highlightMatches(cells []Cell) []Cell {
+	length := len([]rune(self.SearchText))
+	for _, i := range findMatches(cells, self.SearchText) {
+		for j := i; j < i+length; j++ {
+			cells[j].Style = self.SearchStyle
+		}
+	}
+	return cells
+}
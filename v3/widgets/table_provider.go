@@ -0,0 +1,25 @@
+package widgets
+
+// TableDataProvider supplies the rows of a Table on demand instead of Rows,
+// so that only the rows in view are materialized, e.g. for datasets with millions of rows.
+// Row 0 is the header, like in Rows.
+type TableDataProvider interface {
+	RowCount() int
+	Row(i int) []string
+}
+
+// rowCount returns the number of rows including the header.
+func (self *Table) rowCount() int {
+	if self.DataProvider != nil {
+		return self.DataProvider.RowCount()
+	}
+	return len(self.Rows)
+}
+
+// row returns the row with the given index.
+func (self *Table) row(i int) []string {
+	if self.DataProvider != nil {
+		return self.DataProvider.Row(i)
+	}
+	return self.Rows[i]
+}
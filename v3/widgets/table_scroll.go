@@ -0,0 +1,51 @@
+package widgets
+
+import (
+	. "github.com/s-westphal/termui/v3"
+)
+
+// visibleColumns returns the indices of the columns in the order they are drawn:
+// the frozen columns followed by the scrolling ones starting at leftColumn.
+// If the Table is Editable, leftColumn is adjusted so that the SelectedColumn is visible.
+func (self *Table) visibleColumns(columnWidths []int) []int {
+	frozen := MaxInt(MinInt(self.FrozenColumns, len(columnWidths)), 0)
+	self.leftColumn = MaxInt(MinInt(self.leftColumn, len(columnWidths)-frozen-1), 0)
+
+	if self.Editable && self.SelectedColumn >= frozen && self.SelectedColumn < len(columnWidths) {
+		if self.SelectedColumn < frozen+self.leftColumn {
+			self.leftColumn = self.SelectedColumn - frozen
+		}
+		width := func() int {
+			w := 0
+			for j := 0; j < frozen; j++ {
+				w += columnWidths[j] + 1
+			}
+			for j := frozen + self.leftColumn; j <= self.SelectedColumn; j++ {
+				w += columnWidths[j] + 1
+			}
+			return w
+		}
+		for self.leftColumn < self.SelectedColumn-frozen && width()-1 > self.Inner.Dx() {
+			self.leftColumn++
+		}
+	}
+
+	columns := make([]int, 0, len(columnWidths))
+	for j := 0; j < frozen; j++ {
+		columns = append(columns, j)
+	}
+	for j := frozen + self.leftColumn; j < len(columnWidths); j++ {
+		columns = append(columns, j)
+	}
+	return columns
+}
+
+// ScrollLeft scrolls the columns after the FrozenColumns one column to the left.
+func (self *Table) ScrollLeft() {
+	self.leftColumn = MaxInt(self.leftColumn-1, 0)
+}
+
+// ScrollRight scrolls the columns after the FrozenColumns one column to the right.
+func (self *Table) ScrollRight() {
+	self.leftColumn++
+}
@@ -0,0 +1,111 @@
+package widgets
+
+import (
+	"image"
+	"sort"
+	"strconv"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+type SortOrder uint
+
+const (
+	SortNone SortOrder = iota
+	SortAscending
+	SortDescending
+)
+
+// TableComparator reports whether cell a should sort before cell b.
+type TableComparator func(a, b string) bool
+
+// DefaultTableComparator compares cells numerically if both of them are numbers, and lexically otherwise.
+// Embedded styles are ignored.
+func DefaultTableComparator(a, b string) bool {
+	a = CellsToString(ParseStyles(a, StyleClear))
+	b = CellsToString(ParseStyles(b, StyleClear))
+	x, errA := strconv.ParseFloat(a, 64)
+	y, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		return x < y
+	}
+	return a < b
+}
+
+// SortBy sorts all rows but the header by the given column. Sorting is stable and
+// RowStyles, CellStyles, CellSpans and the SelectedRow move along with their rows.
+// SortNone leaves the rows as they are and only removes the sort indicator from the header.
+func (self *Table) SortBy(column int, order SortOrder) {
+	self.SortColumn = column
+	self.SortOrder = order
+	if order == SortNone || len(self.Rows) < 2 {
+		return
+	}
+
+	less := TableComparator(DefaultTableComparator)
+	if comparator, ok := self.ColumnComparators[column]; ok {
+		less = comparator
+	}
+	cell := func(i int) string {
+		if column < len(self.Rows[i]) {
+			return self.Rows[i][column]
+		}
+		return ""
+	}
+
+	indices := make([]int, len(self.Rows)-1)
+	for i := range indices {
+		indices[i] = i + 1
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		if order == SortDescending {
+			return less(cell(indices[j]), cell(indices[i]))
+		}
+		return less(cell(indices[i]), cell(indices[j]))
+	})
+
+	// newIndex maps the old row indices to the sorted ones
+	newIndex := map[int]int{0: 0}
+	rows := [][]string{self.Rows[0]}
+	for i, index := range indices {
+		newIndex[index] = i + 1
+		rows = append(rows, self.Rows[index])
+	}
+	rowStyles := make(map[int]Style)
+	for i, style := range self.RowStyles {
+		if j, ok := newIndex[i]; ok {
+			rowStyles[j] = style
+		}
+	}
+	cellStyles := make(map[image.Point]Style)
+	for point, style := range self.CellStyles {
+		if j, ok := newIndex[point.Y]; ok {
+			cellStyles[image.Pt(point.X, j)] = style
+		}
+	}
+	cellSpans := make(map[image.Point]CellSpan)
+	for point, span := range self.CellSpans {
+		if j, ok := newIndex[point.Y]; ok {
+			cellSpans[image.Pt(point.X, j)] = span
+		}
+	}
+
+	self.Rows = rows
+	self.RowStyles = rowStyles
+	self.CellStyles = cellStyles
+	self.CellSpans = cellSpans
+	// keep the cursor on the same row
+	if j, ok := newIndex[self.SelectedRow]; ok {
+		self.SelectedRow = j
+	}
+}
+
+// ToggleSort sorts by the given column, ascending if the Table isn't sorted by it yet
+// and flipping the order otherwise.
+func (self *Table) ToggleSort(column int) {
+	order := SortAscending
+	if self.SortColumn == column && self.SortOrder == SortAscending {
+		order = SortDescending
+	}
+	self.SortBy(column, order)
+}
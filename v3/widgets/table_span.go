@@ -0,0 +1,81 @@
+package widgets
+
+import (
+	"image"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// CellSpan is the number of columns and rows taken up by a cell, see Table.CellSpans.
+type CellSpan struct {
+	Columns int
+	Rows    int
+}
+
+// tableCell is a cell as drawn in a row: a single column or the visible part of a span.
+type tableCell struct {
+	// column is the first visible column of the cell.
+	column int
+	// origin is the top left cell of the span, or the cell itself.
+	origin image.Point
+	span   CellSpan
+	x      int
+	width  int
+	// last is the last visible column of the cell.
+	last int
+}
+
+// cellSpan returns the top left cell and the size of the span covering the given cell.
+func (self *Table) cellSpan(column, row int) (image.Point, CellSpan) {
+	if span, ok := self.CellSpans[image.Pt(column, row)]; ok {
+		return image.Pt(column, row), span
+	}
+	for origin, span := range self.CellSpans {
+		if column >= origin.X && column < origin.X+span.Columns && row >= origin.Y && row < origin.Y+span.Rows {
+			return origin, span
+		}
+	}
+	return image.Pt(column, row), CellSpan{1, 1}
+}
+
+// rowCells returns the cells of the given row in the order they are drawn,
+// merging adjacent columns that are covered by the same span.
+func (self *Table) rowCells(row int, columns []int, columnWidths []int) []tableCell {
+	cells := make([]tableCell, 0, len(columns))
+	x := self.Inner.Min.X
+	for k := 0; k < len(columns); k++ {
+		j := columns[k]
+		origin, span := self.cellSpan(j, row)
+		cell := tableCell{column: j, origin: origin, span: span, x: x, width: columnWidths[j], last: j}
+		for k+1 < len(columns) && columns[k+1] == cell.last+1 && columns[k+1] < origin.X+MaxInt(span.Columns, 1) {
+			k++
+			cell.last = columns[k]
+			cell.width += columnWidths[cell.last] + 1
+		}
+		cells = append(cells, cell)
+		x += cell.width + 1
+	}
+	return cells
+}
+
+// coversRow returns whether the span of the cell continues in the given row.
+func (self *tableCell) coversRow(row int) bool {
+	return row > self.origin.Y && row < self.origin.Y+self.span.Rows
+}
+
+// drawRowSeparator draws the horizontal separator below row, leaving out the cells
+// whose span continues in the next row.
+func (self *Table) drawRowSeparator(buf *Buffer, cells []tableCell, next int, yCoordinate int) {
+	style := self.Block.BorderStyle
+	buf.Fill(NewCell(HORIZONTAL_LINE, style), image.Rect(self.Inner.Min.X, yCoordinate, self.Inner.Max.X, yCoordinate+1))
+	for _, cell := range cells {
+		if !cell.coversRow(next) {
+			continue
+		}
+		buf.Fill(NewCell(' ', self.TextStyle), image.Rect(cell.x, yCoordinate, MinInt(cell.x+cell.width, self.Inner.Max.X), yCoordinate+1))
+		if cell.x > self.Inner.Min.X {
+			buf.SetCell(NewCell(VERTICAL_LINE, style), image.Pt(cell.x-1, yCoordinate))
+		}
+		buf.SetCell(NewCell(VERTICAL_LINE, style), image.Pt(cell.x+cell.width, yCoordinate))
+	}
+}
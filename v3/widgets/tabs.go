@@ -7,6 +7,7 @@ package widgets
 import (
 	"image"
 
+	rw "github.com/mattn/go-runewidth"
 	. "github.com/s-westphal/termui/v3"
 )
 
@@ -19,6 +20,43 @@ type TabPane struct {
 	ActiveTabIndex   int
 	ActiveTabStyle   Style
 	InactiveTabStyle Style
+
+	// LabelShortener is used to shorten tab names that don't fit. Defaults to TrimString.
+	LabelShortener LabelShortener
+
+	// Closable draws a close button with CloseStyle after every tab name, see HandleEvent.
+	Closable   bool
+	CloseStyle Style
+
+	// OnChange is called with the index of the active tab when another tab is activated,
+	// OnClose with the index and name of a closed tab and OnReorder when a tab was moved.
+	OnChange  func(index int)
+	OnClose   func(index int, name string)
+	OnReorder func(from, to int)
+
+	// Badges are shown after the tab names in BadgeStyle by index, e.g. an unread count or
+	// a DOT as status.
+	Badges     []string
+	BadgeStyle Style
+
+	// Accelerators lets the entries of TabNames mark their accelerator with '&', as in "&Logs",
+	// which is underlined and activates the tab, see ActivateAccelerator. "&&" is a literal '&'.
+	// Otherwise the names are shown verbatim.
+	Accelerators bool
+
+	// Contents are the root widgets of the tabs by index, e.g. a Grid. The content of the
+	// active tab is resized to the area below the tab names and drawn with the TabPane.
+	// It receives the events HandleEvent doesn't use if it has a HandleEvent method.
+	// ContentFocus holds a FocusManager per content for the widgets within it. Its focused
+	// widget receives the events first, then the FocusManager and then the content.
+	// Left clicks focus the widget below the mouse.
+	Contents     []Drawable
+	ContentFocus map[Drawable]*FocusManager
+
+	dragging bool
+	// firstTab is the first tab shown if the tabs don't fit, lastTab the last one shown in full.
+	firstTab int
+	lastTab  int
 }
 
 func NewTabPane(names ...string) *TabPane {
@@ -27,39 +65,74 @@ func NewTabPane(names ...string) *TabPane {
 		TabNames:         names,
 		ActiveTabStyle:   Theme.Tab.Active,
 		InactiveTabStyle: Theme.Tab.Inactive,
+		CloseStyle:       Theme.Tab.Close,
+		BadgeStyle:       Theme.Tab.Badge,
 	}
 }
 
 func (self *TabPane) FocusLeft() {
 	if self.ActiveTabIndex > 0 {
-		self.ActiveTabIndex--
+		self.SelectTab(self.ActiveTabIndex - 1)
 	}
 }
 
 func (self *TabPane) FocusRight() {
 	if self.ActiveTabIndex < len(self.TabNames)-1 {
-		self.ActiveTabIndex++
+		self.SelectTab(self.ActiveTabIndex + 1)
 	}
 }
 
 func (self *TabPane) Draw(buf *Buffer) {
 	self.Block.Draw(buf)
 
-	xCoordinate := self.Inner.Min.X
-	for i, name := range self.TabNames {
+	left, right := self.Inner.Min.X, self.Inner.Max.X
+	overflow := self.tabsWidth(0, len(self.TabNames)) > right-left
+	if overflow {
+		// leave room for the scroll arrows
+		left, right = left+2, right-2
+		self.scrollToActiveTab(right - left)
+	} else {
+		self.firstTab = 0
+	}
+
+	xCoordinate := left
+	self.lastTab = self.firstTab - 1
+	for i := self.firstTab; i < len(self.TabNames) && xCoordinate < right; i++ {
+		name, accelerator := self.tabName(i)
 		ColorPair := self.InactiveTabStyle
 		if i == self.ActiveTabIndex {
 			ColorPair = self.ActiveTabStyle
 		}
+		shorten := self.LabelShortener
+		if shorten == nil {
+			shorten = TrimString
+		}
+		label := shorten(name, right-xCoordinate)
 		buf.SetString(
-			TrimString(name, self.Inner.Max.X-xCoordinate),
+			label,
 			ColorPair,
 			image.Pt(xCoordinate, self.Inner.Min.Y),
 		)
+		self.underlineAccelerator(buf, name, label, accelerator, ColorPair, xCoordinate)
+		buf.AddHitRegion(self, "tab", image.Rect(xCoordinate, self.Inner.Min.Y, xCoordinate+rw.StringWidth(label), self.Inner.Min.Y+1), i)
+		if xCoordinate+self.tabWidth(i) <= right {
+			self.lastTab = i
+		}
+
+		xCoordinate += 1 + rw.StringWidth(name)
+
+		if badge := self.badge(i); badge != "" && xCoordinate < right {
+			buf.SetString(TrimString(badge, right-xCoordinate), self.BadgeStyle, image.Pt(xCoordinate, self.Inner.Min.Y))
+			xCoordinate += 1 + rw.StringWidth(badge)
+		}
 
-		xCoordinate += 1 + len(name)
+		if self.Closable && xCoordinate < right {
+			buf.SetCell(NewCell(CLOSE, self.CloseStyle), image.Pt(xCoordinate, self.Inner.Min.Y))
+			buf.AddHitRegion(self, "closeTab", image.Rect(xCoordinate, self.Inner.Min.Y, xCoordinate+1, self.Inner.Min.Y+1), i)
+			xCoordinate += 2
+		}
 
-		if i < len(self.TabNames)-1 && xCoordinate < self.Inner.Max.X {
+		if i < len(self.TabNames)-1 && xCoordinate < right {
 			buf.SetCell(
 				NewCell(VERTICAL_LINE, NewStyle(ColorWhite)),
 				image.Pt(xCoordinate, self.Inner.Min.Y),
@@ -68,4 +141,9 @@ func (self *TabPane) Draw(buf *Buffer) {
 
 		xCoordinate += 2
 	}
+
+	if overflow {
+		self.drawScrollArrows(buf)
+	}
+	self.drawContent(buf)
 }
@@ -0,0 +1,80 @@
+package widgets
+
+import (
+	"image"
+	"strings"
+	"unicode"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// parseAccelerator returns the name without the '&' marking the accelerator and the index
+// of the accelerator rune in the name, or -1 if it has none.
+func parseAccelerator(name string) (string, int) {
+	if !strings.ContainsRune(name, '&') {
+		return name, -1
+	}
+	runes := []rune(name)
+	label := make([]rune, 0, len(runes))
+	accelerator := -1
+	for i := 0; i < len(runes); i++ {
+		if runes[i] == '&' && i+1 < len(runes) {
+			i++
+			if runes[i] != '&' && accelerator < 0 {
+				accelerator = len(label)
+			}
+		}
+		label = append(label, runes[i])
+	}
+	return string(label), accelerator
+}
+
+// tabName returns the name of tab i as it is shown and the index of its accelerator,
+// which is -1 if it has none or Accelerators isn't set.
+func (self *TabPane) tabName(i int) (string, int) {
+	if !self.Accelerators {
+		return self.TabNames[i], -1
+	}
+	return parseAccelerator(self.TabNames[i])
+}
+
+// Accelerator returns the accelerator of tab i in lower case, or 0 if it has none.
+func (self *TabPane) Accelerator(i int) rune {
+	if i < 0 || i >= len(self.TabNames) {
+		return 0
+	}
+	name, accelerator := self.tabName(i)
+	if accelerator < 0 {
+		return 0
+	}
+	return unicode.ToLower([]rune(name)[accelerator])
+}
+
+// ActivateAccelerator activates the first tab whose accelerator is key, ignoring case,
+// and returns whether there is one. HandleEvent calls it for Alt+key, e.g. <M-l>.
+func (self *TabPane) ActivateAccelerator(key rune) bool {
+	key = unicode.ToLower(key)
+	for i := range self.TabNames {
+		if self.Accelerator(i) == key {
+			self.SelectTab(i)
+			return true
+		}
+	}
+	return false
+}
+
+// underlineAccelerator underlines the accelerator of a tab drawn at x, unless it was cut off.
+func (self *TabPane) underlineAccelerator(buf *Buffer, name, label string, accelerator int, style Style, x int) {
+	nameRunes, labelRunes := []rune(name), []rune(label)
+	// the last rune of a shortened label is the ellipsis
+	if accelerator < 0 || accelerator >= len(labelRunes) || labelRunes[accelerator] != nameRunes[accelerator] ||
+		(label != name && accelerator == len(labelRunes)-1) {
+		return
+	}
+	style.Modifier |= ModifierUnderline
+	buf.SetCell(
+		NewCell(labelRunes[accelerator], style),
+		image.Pt(x+rw.StringWidth(string(labelRunes[:accelerator])), self.Inner.Min.Y),
+	)
+}
@@ -0,0 +1,82 @@
+package widgets
+
+import (
+	"image"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// AddContentTab appends a tab with the given content.
+func (self *TabPane) AddContentTab(name string, content Drawable) {
+	self.AddTab(name)
+	self.SetContent(len(self.TabNames)-1, content)
+}
+
+// SetContent sets the content of the tab at index.
+func (self *TabPane) SetContent(index int, content Drawable) {
+	if index < 0 || index >= len(self.TabNames) {
+		return
+	}
+	self.Contents = self.contents()
+	self.Contents[index] = content
+}
+
+// ActiveContent returns the content of the active tab, or nil if it has none.
+func (self *TabPane) ActiveContent() Drawable {
+	if self.ActiveTabIndex < 0 || self.ActiveTabIndex >= len(self.Contents) {
+		return nil
+	}
+	return self.Contents[self.ActiveTabIndex]
+}
+
+// contents returns the Contents with an entry for every tab.
+func (self *TabPane) contents() []Drawable {
+	if len(self.Contents) >= len(self.TabNames) {
+		return self.Contents
+	}
+	return append(self.Contents, make([]Drawable, len(self.TabNames)-len(self.Contents))...)
+}
+
+// ContentRect returns the area below the tab names that the content of the active tab covers.
+func (self *TabPane) ContentRect() image.Rectangle {
+	return image.Rect(self.Inner.Min.X, MinInt(self.Inner.Min.Y+1, self.Inner.Max.Y), self.Inner.Max.X, self.Inner.Max.Y)
+}
+
+// drawContent resizes the content of the active tab to the ContentRect and draws it.
+func (self *TabPane) drawContent(buf *Buffer) {
+	content := self.ActiveContent()
+	rect := self.ContentRect()
+	if content == nil || rect.Empty() {
+		return
+	}
+	content.SetRect(rect.Min.X, rect.Min.Y, rect.Max.X, rect.Max.Y)
+	content.Lock()
+	content.Draw(buf)
+	content.Unlock()
+}
+
+// handleContentEvent passes the event to the focused widget of the active tab,
+// its FocusManager and its content, until one of them uses it.
+func (self *TabPane) handleContentEvent(e Event) bool {
+	content := self.ActiveContent()
+	if focus := self.ContentFocus[content]; focus != nil {
+		if mouse, ok := e.Payload.(Mouse); ok && e.ID == "<MouseLeft>" {
+			focus.FocusAt(image.Pt(mouse.X, mouse.Y))
+		}
+		if focused := focus.Focused(); focused != content && handleEvent(focused, e) {
+			return true
+		}
+		if focus.HandleEvent(e) {
+			return true
+		}
+	}
+	return handleEvent(content, e)
+}
+
+// handleEvent passes the event to the widget if it has a HandleEvent method.
+func handleEvent(widget Drawable, e Event) bool {
+	if handler, ok := widget.(interface{ HandleEvent(Event) bool }); ok {
+		return handler.HandleEvent(e)
+	}
+	return false
+}
@@ -0,0 +1,215 @@
+package widgets
+
+import (
+	"strings"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// SelectTab activates the tab at index and calls OnChange if the active tab changed.
+func (self *TabPane) SelectTab(index int) {
+	if index < 0 || index >= len(self.TabNames) || index == self.ActiveTabIndex {
+		return
+	}
+	self.ActiveTabIndex = index
+	if self.OnChange != nil {
+		self.OnChange(index)
+	}
+}
+
+// SetBadge sets the badge of the tab at index, see Badges.
+func (self *TabPane) SetBadge(index int, badge string) {
+	if index < 0 || index >= len(self.TabNames) {
+		return
+	}
+	self.Badges = self.badges()
+	self.Badges[index] = badge
+}
+
+// badge returns the badge of tab i, or "" if it has none.
+func (self *TabPane) badge(i int) string {
+	if i < len(self.Badges) {
+		return self.Badges[i]
+	}
+	return ""
+}
+
+// badges returns the Badges with an entry for every tab.
+func (self *TabPane) badges() []string {
+	if len(self.Badges) >= len(self.TabNames) {
+		return self.Badges
+	}
+	return append(self.Badges, make([]string, len(self.TabNames)-len(self.Badges))...)
+}
+
+// AddTab appends a tab.
+func (self *TabPane) AddTab(name string) {
+	self.InsertTab(len(self.TabNames), name)
+}
+
+// InsertTab inserts a tab at index, shifting the following tabs to the right.
+// The active tab stays active.
+func (self *TabPane) InsertTab(index int, name string) {
+	index = MaxInt(MinInt(index, len(self.TabNames)), 0)
+	if self.Contents != nil {
+		contents := self.contents()
+		self.Contents = append(contents[:index], append([]Drawable{nil}, contents[index:]...)...)
+	}
+	if self.Badges != nil {
+		badges := self.badges()
+		self.Badges = append(badges[:index], append([]string{""}, badges[index:]...)...)
+	}
+	self.TabNames = append(self.TabNames[:index], append([]string{name}, self.TabNames[index:]...)...)
+	if index <= self.ActiveTabIndex && len(self.TabNames) > 1 {
+		self.ActiveTabIndex++
+	}
+}
+
+// RemoveTab removes the tab at index. If it was active, the tab that takes its place,
+// or the new last tab, is activated and OnChange is called. The ContentFocus of its
+// content is dropped.
+func (self *TabPane) RemoveTab(index int) {
+	if index < 0 || index >= len(self.TabNames) {
+		return
+	}
+	if index < len(self.Contents) {
+		delete(self.ContentFocus, self.Contents[index])
+		self.Contents = append(self.Contents[:index], self.Contents[index+1:]...)
+	}
+	if index < len(self.Badges) {
+		self.Badges = append(self.Badges[:index], self.Badges[index+1:]...)
+	}
+	self.TabNames = append(self.TabNames[:index], self.TabNames[index+1:]...)
+	switch {
+	case index < self.ActiveTabIndex:
+		self.ActiveTabIndex--
+	case index == self.ActiveTabIndex:
+		self.ActiveTabIndex = MaxInt(MinInt(index, len(self.TabNames)-1), 0)
+		if len(self.TabNames) > 0 && self.OnChange != nil {
+			self.OnChange(self.ActiveTabIndex)
+		}
+	}
+}
+
+// CloseTab removes the tab at index like RemoveTab and calls OnClose.
+func (self *TabPane) CloseTab(index int) {
+	if index < 0 || index >= len(self.TabNames) {
+		return
+	}
+	name := self.TabNames[index]
+	self.RemoveTab(index)
+	if self.OnClose != nil {
+		self.OnClose(index, name)
+	}
+}
+
+// CloseActiveTab closes the active tab if the TabPane is Closable.
+func (self *TabPane) CloseActiveTab() {
+	if self.Closable {
+		self.CloseTab(self.ActiveTabIndex)
+	}
+}
+
+// MoveTab moves the tab at index from to index to, shifting the tabs in between,
+// and calls OnReorder. The active tab stays active.
+func (self *TabPane) MoveTab(from, to int) {
+	count := len(self.TabNames)
+	if from == to || from < 0 || to < 0 || from >= count || to >= count {
+		return
+	}
+	name := self.TabNames[from]
+	self.TabNames = append(self.TabNames[:from], self.TabNames[from+1:]...)
+	self.TabNames = append(self.TabNames[:to], append([]string{name}, self.TabNames[to:]...)...)
+	if self.Contents != nil {
+		contents := self.contents()
+		content := contents[from]
+		contents = append(contents[:from], contents[from+1:]...)
+		self.Contents = append(contents[:to], append([]Drawable{content}, contents[to:]...)...)
+	}
+	if self.Badges != nil {
+		badges := self.badges()
+		badge := badges[from]
+		badges = append(badges[:from], badges[from+1:]...)
+		self.Badges = append(badges[:to], append([]string{badge}, badges[to:]...)...)
+	}
+
+	switch active := self.ActiveTabIndex; {
+	case active == from:
+		self.ActiveTabIndex = to
+	case from < to && active > from && active <= to:
+		self.ActiveTabIndex--
+	case to < from && active >= to && active < from:
+		self.ActiveTabIndex++
+	}
+
+	if self.OnReorder != nil {
+		self.OnReorder(from, to)
+	}
+}
+
+// HandleEvent activates clicked tabs, reorders tabs dragged with the left mouse button and,
+// if the TabPane is Closable, closes tabs whose close button is clicked, tabs clicked with
+// the middle mouse button and the active tab with <C-w>. Alt with the accelerator of a tab
+// activates it. Clicking a scroll arrow activates
+// the next hidden tab. It returns whether the event was used.
+// Clicks are resolved with the hit regions of the last Render. Other events are passed to
+// the content of the active tab, see Contents.
+func (self *TabPane) HandleEvent(e Event) bool {
+	return self.handleTabEvent(e) || self.handleContentEvent(e)
+}
+
+func (self *TabPane) handleTabEvent(e Event) bool {
+	if e.Type == KeyboardEvent {
+		if e.ID == "<C-w>" && self.Closable && len(self.TabNames) > 0 {
+			self.CloseActiveTab()
+			return true
+		}
+		if strings.HasPrefix(e.ID, "<M-") && strings.HasSuffix(e.ID, ">") {
+			if key := []rune(e.ID[3 : len(e.ID)-1]); len(key) == 1 {
+				return self.ActivateAccelerator(key[0])
+			}
+		}
+		return false
+	}
+	mouse, ok := e.Payload.(Mouse)
+	if e.Type != MouseEvent || !ok {
+		return false
+	}
+	if e.ID == "<MouseRelease>" {
+		dragging := self.dragging
+		self.dragging = false
+		return dragging
+	}
+
+	region, ok := ResolveHitRegion(e)
+	if !ok || region.Widget != Drawable(self) {
+		return self.dragging && e.ID == "<MouseLeft>"
+	}
+	index, _ := region.Payload.(int)
+	switch e.ID {
+	case "<MouseLeft>":
+		if mouse.Drag && self.dragging {
+			if region.Name == "tab" {
+				self.MoveTab(self.ActiveTabIndex, index)
+			}
+			return true
+		}
+		switch region.Name {
+		case "closeTab":
+			self.CloseTab(index)
+			return true
+		case "scrollTabsLeft", "scrollTabsRight":
+			self.SelectTab(index)
+			return true
+		}
+		self.SelectTab(index)
+		self.dragging = true
+		return true
+	case "<MouseMiddle>":
+		if self.Closable && (region.Name == "tab" || region.Name == "closeTab") {
+			self.CloseTab(index)
+			return true
+		}
+	}
+	return false
+}
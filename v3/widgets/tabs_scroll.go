@@ -0,0 +1,62 @@
+package widgets
+
+import (
+	"image"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// tabWidth returns the number of cells the name, badge and close button of tab i take up.
+func (self *TabPane) tabWidth(i int) int {
+	name, _ := self.tabName(i)
+	width := rw.StringWidth(name)
+	if badge := self.badge(i); badge != "" {
+		width += 1 + rw.StringWidth(badge)
+	}
+	if self.Closable {
+		width += 2
+	}
+	return width
+}
+
+// tabsWidth returns the number of cells the tabs from index from up to to take up,
+// including the separators.
+func (self *TabPane) tabsWidth(from, to int) int {
+	width := 0
+	for i := from; i < to; i++ {
+		if i > from {
+			width += 3
+		}
+		width += self.tabWidth(i)
+	}
+	return width
+}
+
+// scrollToActiveTab adjusts the first shown tab so that the active tab fits into width
+// cells, and shows as many tabs as possible on its left.
+func (self *TabPane) scrollToActiveTab(width int) {
+	active := MaxInt(MinInt(self.ActiveTabIndex, len(self.TabNames)-1), 0)
+	self.firstTab = MaxInt(MinInt(self.firstTab, active), 0)
+	for self.firstTab < active && self.tabsWidth(self.firstTab, active+1) > width {
+		self.firstTab++
+	}
+	for self.firstTab > 0 && self.tabsWidth(self.firstTab-1, len(self.TabNames)) <= width {
+		self.firstTab--
+	}
+}
+
+// drawScrollArrows draws arrows at the edges of the tab names if there are hidden tabs on
+// that side. Clicking an arrow activates the next hidden tab, see HandleEvent.
+func (self *TabPane) drawScrollArrows(buf *Buffer) {
+	style := NewStyle(ColorWhite)
+	y := self.Inner.Min.Y
+	if self.firstTab > 0 {
+		buf.SetCell(NewCell(QUOTA_LEFT, style), image.Pt(self.Inner.Min.X, y))
+		buf.AddHitRegion(self, "scrollTabsLeft", image.Rect(self.Inner.Min.X, y, self.Inner.Min.X+1, y+1), self.firstTab-1)
+	}
+	if self.lastTab < len(self.TabNames)-1 {
+		buf.SetCell(NewCell(QUOTA_RIGHT, style), image.Pt(self.Inner.Max.X-1, y))
+		buf.AddHitRegion(self, "scrollTabsRight", image.Rect(self.Inner.Max.X-1, y, self.Inner.Max.X, y+1), self.lastTab+1)
+	}
+}
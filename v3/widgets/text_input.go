@@ -0,0 +1,137 @@
+package widgets
+
+import (
+	"image"
+
+	rw "github.com/mattn/go-runewidth"
+	. "github.com/s-westphal/termui/v3"
+)
+
+// TextInput is a single line text input. Long text scrolls horizontally to keep the cursor
+// in view. Keyboard and mouse events are handled by HandleEvent.
+type TextInput struct {
+	Block
+	Text      string
+	TextStyle Style
+
+	// Cursor is the index of the rune before which text is inserted.
+	Cursor int
+
+	// Placeholder is shown in PlaceholderStyle while the Text is empty.
+	Placeholder      string
+	PlaceholderStyle Style
+
+	// SelectionStyle is the style of the selected text, see SelectAll.
+	SelectionStyle Style
+
+	// Mask replaces every rune of the Text if it is set, e.g. '*' for passwords.
+	// Word movement treats masked text as a single word.
+	Mask rune
+
+	// HideCursor doesn't draw the cursor, e.g. while the TextInput isn't focused.
+	HideCursor bool
+
+	// MaxLength is the maximum number of runes of the Text, 0 is unlimited.
+	MaxLength int
+
+	// OnChange is called with the Text whenever it is edited, OnSubmit when Enter is pressed.
+	OnChange func(text string)
+	OnSubmit func(text string)
+
+	// anchor is the other end of the selection if selecting is set.
+	anchor    int
+	selecting bool
+	// offset is the index of the first rune shown.
+	offset   int
+	dragging bool
+}
+
+func NewTextInput() *TextInput {
+	return &TextInput{
+		Block:            *NewBlock(),
+		TextStyle:        Theme.TextInput.Text,
+		PlaceholderStyle: Theme.TextInput.Placeholder,
+		SelectionStyle:   Theme.TextInput.Selection,
+	}
+}
+
+// displayRunes returns the runes that are shown for the Text, which are masked if Mask is set.
+func (self *TextInput) displayRunes() []rune {
+	runes := []rune(self.Text)
+	if self.Mask != 0 {
+		for i := range runes {
+			runes[i] = self.Mask
+		}
+	}
+	return runes
+}
+
+// scrollToCursor adjusts the offset so that the cursor is visible within width cells.
+func (self *TextInput) scrollToCursor(runes []rune, width int) {
+	self.offset = MaxInt(MinInt(self.offset, self.Cursor), 0)
+	// the cursor takes up a cell after the text
+	for self.offset < self.Cursor && rw.StringWidth(string(runes[self.offset:self.Cursor]))+1 > width {
+		self.offset++
+	}
+}
+
+func (self *TextInput) Draw(buf *Buffer) {
+	self.Block.Draw(buf)
+
+	width := self.Inner.Dx()
+	if width <= 0 || self.Inner.Dy() <= 0 {
+		return
+	}
+	runes := self.displayRunes()
+	self.Cursor = MaxInt(MinInt(self.Cursor, len(runes)), 0)
+	self.scrollToCursor(runes, width)
+	y := self.Inner.Min.Y
+
+	if len(runes) == 0 && self.Placeholder != "" {
+		buf.SetString(TrimString(self.Placeholder, width), self.PlaceholderStyle, image.Pt(self.Inner.Min.X, y))
+	}
+
+	start, end := self.Selection()
+	x := self.Inner.Min.X
+	for i := self.offset; i <= len(runes) && x < self.Inner.Max.X; i++ {
+		if i == len(runes) && (i != self.Cursor || self.HideCursor) {
+			break
+		}
+		cell := NewCell(' ', self.TextStyle)
+		if i < len(runes) {
+			cell.Rune = runes[i]
+		} else if len(runes) == 0 && self.Placeholder != "" {
+			cell = buf.GetCell(image.Pt(x, y))
+		}
+		if i >= start && i < end {
+			cell.Style = self.SelectionStyle
+		}
+		if i == self.Cursor && !self.HideCursor {
+			cell.Style.Modifier |= ModifierReverse
+		}
+		cellWidth := MaxInt(rw.RuneWidth(cell.Rune), 1)
+		if x+cellWidth > self.Inner.Max.X {
+			break
+		}
+		buf.SetCell(cell, image.Pt(x, y))
+		x += cellWidth
+	}
+}
+
+// positionAt returns the index of the rune drawn at column x by the last Draw.
+func (self *TextInput) positionAt(x int) int {
+	runes := self.displayRunes()
+	position := MinInt(self.offset, len(runes))
+	for column := self.Inner.Min.X; position < len(runes); position++ {
+		column += MaxInt(rw.RuneWidth(runes[position]), 1)
+		if column > x {
+			break
+		}
+	}
+	return position
+}
+
+// FormValue returns the Text, see Form.
+func (self *TextInput) FormValue() interface{} {
+	return self.Text
+}
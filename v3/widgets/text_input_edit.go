@@ -0,0 +1,300 @@
+package widgets
+
+import (
+	"image"
+	"unicode"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// SetText replaces the Text, moves the Cursor to its end and clears the selection.
+func (self *TextInput) SetText(text string) {
+	self.Text = text
+	self.Cursor = len([]rune(text))
+	self.selecting = false
+}
+
+// Selection returns the rune indices of the start and end of the selected text,
+// which are equal if nothing is selected.
+func (self *TextInput) Selection() (int, int) {
+	if !self.selecting {
+		return self.Cursor, self.Cursor
+	}
+	length := len([]rune(self.Text))
+	start := MaxInt(MinInt(self.anchor, self.Cursor), 0)
+	end := MinInt(MaxInt(self.anchor, self.Cursor), length)
+	return start, MaxInt(end, start)
+}
+
+// SelectedText returns the selected text.
+func (self *TextInput) SelectedText() string {
+	start, end := self.Selection()
+	return string([]rune(self.Text)[start:end])
+}
+
+// SelectAll selects the whole Text.
+func (self *TextInput) SelectAll() {
+	self.anchor = 0
+	self.Cursor = len([]rune(self.Text))
+	self.selecting = true
+}
+
+// ClearSelection deselects the text, keeping the Cursor.
+func (self *TextInput) ClearSelection() {
+	self.selecting = false
+}
+
+// moveTo moves the Cursor to position, extending the selection if extend is set
+// and clearing it otherwise.
+func (self *TextInput) moveTo(position int, extend bool) {
+	if extend && !self.selecting {
+		self.anchor = self.Cursor
+		self.selecting = true
+	} else if !extend {
+		self.selecting = false
+	}
+	self.Cursor = MaxInt(MinInt(position, len([]rune(self.Text))), 0)
+}
+
+// wordLeft returns the start of the word before the Cursor.
+func (self *TextInput) wordLeft() int {
+	if self.Mask != 0 {
+		return 0
+	}
+	runes := []rune(self.Text)
+	i := MinInt(self.Cursor, len(runes))
+	for i > 0 && unicode.IsSpace(runes[i-1]) {
+		i--
+	}
+	for i > 0 && !unicode.IsSpace(runes[i-1]) {
+		i--
+	}
+	return i
+}
+
+// wordRight returns the end of the word after the Cursor.
+func (self *TextInput) wordRight() int {
+	runes := []rune(self.Text)
+	if self.Mask != 0 {
+		return len(runes)
+	}
+	i := MaxInt(self.Cursor, 0)
+	for i < len(runes) && unicode.IsSpace(runes[i]) {
+		i++
+	}
+	for i < len(runes) && !unicode.IsSpace(runes[i]) {
+		i++
+	}
+	return i
+}
+
+// MoveLeft moves the Cursor a rune to the left, or to the start of the selection.
+func (self *TextInput) MoveLeft() {
+	if start, _ := self.Selection(); self.selecting {
+		self.moveTo(start, false)
+		return
+	}
+	self.moveTo(self.Cursor-1, false)
+}
+
+// MoveRight moves the Cursor a rune to the right, or to the end of the selection.
+func (self *TextInput) MoveRight() {
+	if _, end := self.Selection(); self.selecting {
+		self.moveTo(end, false)
+		return
+	}
+	self.moveTo(self.Cursor+1, false)
+}
+
+// MoveWordLeft moves the Cursor to the start of the previous word.
+func (self *TextInput) MoveWordLeft() {
+	self.moveTo(self.wordLeft(), false)
+}
+
+// MoveWordRight moves the Cursor to the end of the next word.
+func (self *TextInput) MoveWordRight() {
+	self.moveTo(self.wordRight(), false)
+}
+
+// MoveHome moves the Cursor to the start of the Text.
+func (self *TextInput) MoveHome() {
+	self.moveTo(0, false)
+}
+
+// MoveEnd moves the Cursor to the end of the Text.
+func (self *TextInput) MoveEnd() {
+	self.moveTo(len([]rune(self.Text)), false)
+}
+
+// SelectLeft extends the selection by a rune to the left.
+func (self *TextInput) SelectLeft() {
+	self.moveTo(self.Cursor-1, true)
+}
+
+// SelectRight extends the selection by a rune to the right.
+func (self *TextInput) SelectRight() {
+	self.moveTo(self.Cursor+1, true)
+}
+
+// SelectWordLeft extends the selection to the start of the previous word.
+func (self *TextInput) SelectWordLeft() {
+	self.moveTo(self.wordLeft(), true)
+}
+
+// SelectWordRight extends the selection to the end of the next word.
+func (self *TextInput) SelectWordRight() {
+	self.moveTo(self.wordRight(), true)
+}
+
+// SelectHome extends the selection to the start of the Text.
+func (self *TextInput) SelectHome() {
+	self.moveTo(0, true)
+}
+
+// SelectEnd extends the selection to the end of the Text.
+func (self *TextInput) SelectEnd() {
+	self.moveTo(len([]rune(self.Text)), true)
+}
+
+// replace replaces the runes from start to end with text, moves the Cursor after the
+// inserted text and calls OnChange. Text beyond MaxLength is dropped.
+func (self *TextInput) replace(start, end int, text []rune) {
+	runes := []rune(self.Text)
+	if self.MaxLength > 0 {
+		text = text[:MaxInt(MinInt(len(text), self.MaxLength-(len(runes)-(end-start))), 0)]
+	}
+	if start == end && len(text) == 0 {
+		return
+	}
+	self.Text = string(runes[:start]) + string(text) + string(runes[end:])
+	self.Cursor = start + len(text)
+	self.selecting = false
+	if self.OnChange != nil {
+		self.OnChange(self.Text)
+	}
+}
+
+// Insert inserts text at the Cursor, replacing the selected text.
+func (self *TextInput) Insert(text string) {
+	start, end := self.Selection()
+	self.replace(start, end, []rune(text))
+}
+
+// DeleteBackward deletes the selected text or the rune before the Cursor.
+func (self *TextInput) DeleteBackward() {
+	if start, end := self.Selection(); start != end {
+		self.replace(start, end, nil)
+	} else if self.Cursor > 0 {
+		self.replace(self.Cursor-1, self.Cursor, nil)
+	}
+}
+
+// DeleteForward deletes the selected text or the rune after the Cursor.
+func (self *TextInput) DeleteForward() {
+	if start, end := self.Selection(); start != end {
+		self.replace(start, end, nil)
+	} else if self.Cursor < len([]rune(self.Text)) {
+		self.replace(self.Cursor, self.Cursor+1, nil)
+	}
+}
+
+// DeleteWordBackward deletes the selected text or the word before the Cursor.
+func (self *TextInput) DeleteWordBackward() {
+	if start, end := self.Selection(); start != end {
+		self.replace(start, end, nil)
+	} else {
+		self.replace(self.wordLeft(), self.Cursor, nil)
+	}
+}
+
+// DeleteToStart deletes the text before the Cursor.
+func (self *TextInput) DeleteToStart() {
+	self.replace(0, self.Cursor, nil)
+}
+
+// Submit calls OnSubmit with the Text.
+func (self *TextInput) Submit() {
+	if self.OnSubmit != nil {
+		self.OnSubmit(self.Text)
+	}
+}
+
+// HandleEvent edits the text with keyboard events and moves the cursor with the mouse.
+// Printable keys insert text; the arrows, <Home>, <End>, <C-a> and <C-e> move the cursor,
+// with Alt by words (<M-b>, <M-f> as well); <Backspace>, <Delete>, <C-w> and <C-u> delete;
+// <M-a> selects all and <Enter> submits if there is an OnSubmit. Dragging with the mouse selects text.
+// It returns whether the event was used.
+func (self *TextInput) HandleEvent(e Event) bool {
+	if e.Type == MouseEvent {
+		return self.handleMouseEvent(e)
+	}
+	if e.Type != KeyboardEvent {
+		return false
+	}
+	switch e.ID {
+	case "<Left>", "<C-b>":
+		self.MoveLeft()
+	case "<Right>", "<C-f>":
+		self.MoveRight()
+	case "<M-<Left>>", "<M-b>":
+		self.MoveWordLeft()
+	case "<M-<Right>>", "<M-f>":
+		self.MoveWordRight()
+	case "<Home>", "<C-a>":
+		self.MoveHome()
+	case "<End>", "<C-e>":
+		self.MoveEnd()
+	case "<M-a>":
+		self.SelectAll()
+	case "<Backspace>", "<C-<Backspace>>":
+		self.DeleteBackward()
+	case "<Delete>", "<C-d>":
+		self.DeleteForward()
+	case "<C-w>":
+		self.DeleteWordBackward()
+	case "<C-u>":
+		self.DeleteToStart()
+	case "<Enter>":
+		// without OnSubmit, e.g. in a Form, the event is left to the parent
+		if self.OnSubmit == nil {
+			return false
+		}
+		self.Submit()
+	case "<Space>":
+		self.Insert(" ")
+	default:
+		r := []rune(e.ID)
+		if len(r) != 1 || !unicode.IsPrint(r[0]) {
+			return false
+		}
+		self.Insert(e.ID)
+	}
+	return true
+}
+
+// handleMouseEvent moves the Cursor to clicked text and selects the text it is dragged over.
+func (self *TextInput) handleMouseEvent(e Event) bool {
+	mouse, ok := e.Payload.(Mouse)
+	if !ok {
+		return false
+	}
+	switch e.ID {
+	case "<MouseLeft>":
+		p := image.Pt(mouse.X, mouse.Y)
+		if mouse.Drag && self.dragging {
+			self.moveTo(self.positionAt(p.X), true)
+			return true
+		}
+		if !p.In(self.Inner) {
+			return false
+		}
+		self.moveTo(self.positionAt(p.X), false)
+		self.dragging = true
+		return true
+	case "<MouseRelease>":
+		dragging := self.dragging
+		self.dragging = false
+		return dragging
+	}
+	return false
+}
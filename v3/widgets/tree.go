@@ -17,31 +17,52 @@ type TreeNode struct {
 	Expanded bool
 	Nodes    []*TreeNode
 
+	// Checked is the checkbox state of leaves in Checkboxes mode, see CheckState.
+	Checked bool
+
+	// Style overrides the TextStyle of the Tree and the Style of the NodeRenderer if set.
+	Style *Style
+
 	// level stores the node level in the tree.
 	level int
+	// loaded is set once the children of a LazyTreeValue were loaded.
+	loaded bool
+}
+
+// LazyTreeValue can be implemented by the Value of a TreeNode to load its children
+// on the first expand instead of upfront, e.g. for file systems or remote APIs.
+type LazyTreeValue interface {
+	fmt.Stringer
+	// HasChildren reports whether the node can be expanded, without loading the children.
+	HasChildren() bool
+	LoadChildren() ([]*TreeNode, error)
+}
+
+// hasChildren reports whether the node has or can load children.
+func (self *TreeNode) hasChildren() bool {
+	if len(self.Nodes) > 0 {
+		return true
+	}
+	lazy, ok := self.Value.(LazyTreeValue)
+	return ok && !self.loaded && lazy.HasChildren()
 }
 
 // TreeWalkFn is a function used for walking a Tree.
 // To interrupt the walking process function should return false.
 type TreeWalkFn func(*TreeNode) bool
 
-func (self *TreeNode) parseStyles(style Style) []Cell {
-	var sb strings.Builder
-	if len(self.Nodes) == 0 {
-		sb.WriteString(strings.Repeat(treeIndent, self.level+1))
-	} else {
-		sb.WriteString(strings.Repeat(treeIndent, self.level))
-		if self.Expanded {
-			sb.WriteRune(Theme.Tree.Expanded)
-		} else {
-			sb.WriteRune(Theme.Tree.Collapsed)
-		}
-		sb.WriteByte(' ')
-	}
-	sb.WriteString(self.Value.String())
-	return ParseStyles(sb.String(), style)
+// TreeNodeDecoration customizes how a node is drawn, see Tree.NodeRenderer.
+// Icon is drawn in front of the node value and may contain style markup.
+// Style replaces the TextStyle of the node if set.
+type TreeNodeDecoration struct {
+	Icon  string
+	Style *Style
 }
 
+// TreeNodeRenderer returns the decoration of a node, e.g. an icon for the file type
+// and a color for the git status of a file.
+type TreeNodeRenderer func(node *TreeNode) TreeNodeDecoration
+
 // Tree is a tree widget.
 type Tree struct {
 	Block
@@ -50,10 +71,55 @@ type Tree struct {
 	WrapText         bool
 	SelectedRow      int
 
+	// LabelShortener is used to shorten node values that don't fit if WrapText is false.
+	LabelShortener LabelShortener
+
+	// OnLoadError is called if the children of a LazyTreeValue can't be loaded.
+	// The node stays collapsed and loading is tried again on the next expand.
+	OnLoadError func(node *TreeNode, err error)
+
+	// ExpandedSymbol and CollapsedSymbol are drawn in front of nodes with children.
+	ExpandedSymbol  rune
+	CollapsedSymbol rune
+
+	// NodeRenderer adds icons and styles to the nodes if set.
+	NodeRenderer TreeNodeRenderer
+
+	// Scrollbar replaces the arrows at the right edge if set, e.g. with NewScrollbar().
+	Scrollbar *Scrollbar
+
+	// Checkboxes prefixes the nodes with checkboxes, which are toggled with ToggleChecked.
+	Checkboxes bool
+
+	// OnReparent is called before a node dragged with HandleDragEvent is moved to a new parent,
+	// which is highlighted with DropTargetStyle while dragging. Returning false cancels the move.
+	OnReparent      func(node, parent *TreeNode) bool
+	DropTargetStyle Style
+
+	// Matcher is used by Filter and defaults to SubstringMatcher.
+	// MatchStyle highlights the matched text.
+	Matcher    ListMatcher
+	MatchStyle Style
+
+	// query is the query of Filter, matches are the nodes matching it
+	// and visible are the matches together with their ancestors.
+	// collapsed are the nodes collapsed since the query was set.
+	query     string
+	matches   map[*TreeNode]bool
+	visible   map[*TreeNode]bool
+	collapsed map[*TreeNode]bool
+
+	dragged    *TreeNode
+	dropTarget *TreeNode
+	// lineRows are the rows drawn on each line of the inner area.
+	lineRows []int
+
 	nodes []*TreeNode
 	// rows is flatten nodes for rendering.
 	rows   []*TreeNode
 	topRow int
+	// selectionRect is the area of the selected row as of the last Draw.
+	selectionRect image.Rectangle
 }
 
 // NewTree creates a new Tree widget.
@@ -63,6 +129,10 @@ func NewTree() *Tree {
 		TextStyle:        Theme.Tree.Text,
 		SelectedRowStyle: Theme.Tree.Text,
 		WrapText:         true,
+		MatchStyle:       Theme.Tree.Match,
+		ExpandedSymbol:   Theme.Tree.Expanded,
+		CollapsedSymbol:  Theme.Tree.Collapsed,
+		DropTargetStyle:  Theme.Tree.DropTarget,
 	}
 }
 
@@ -73,16 +143,22 @@ func (self *Tree) SetNodes(nodes []*TreeNode) {
 
 func (self *Tree) prepareNodes() {
 	self.rows = make([]*TreeNode, 0)
+	if self.query != "" {
+		self.filterNodes()
+	}
 	for _, node := range self.nodes {
 		self.prepareNode(node, 0)
 	}
 }
 
 func (self *Tree) prepareNode(node *TreeNode, level int) {
+	if self.query != "" && !self.visible[node] {
+		return
+	}
 	self.rows = append(self.rows, node)
 	node.level = level
 
-	if node.Expanded {
+	if self.isExpanded(node) {
 		for _, n := range node.Nodes {
 			self.prepareNode(n, level+1)
 		}
@@ -111,8 +187,49 @@ func (self *Tree) walk(n *TreeNode, fn TreeWalkFn) bool {
 	return true
 }
 
+// nodeCells renders the node as cells and returns the number of cells in front of the node value.
+// If shorten is not nil, the node value is shortened so that the row fits into maxWidth.
+func (self *Tree) nodeCells(node *TreeNode, maxWidth int, shorten LabelShortener) ([]Cell, int) {
+	var decoration TreeNodeDecoration
+	if self.NodeRenderer != nil {
+		decoration = self.NodeRenderer(node)
+	}
+	style := self.TextStyle
+	if node.Style != nil {
+		style = *node.Style
+	} else if decoration.Style != nil {
+		style = *decoration.Style
+	}
+
+	var sb strings.Builder
+	if !node.hasChildren() {
+		sb.WriteString(strings.Repeat(treeIndent, node.level+1))
+	} else {
+		sb.WriteString(strings.Repeat(treeIndent, node.level))
+		if self.isExpanded(node) {
+			sb.WriteRune(self.ExpandedSymbol)
+		} else {
+			sb.WriteRune(self.CollapsedSymbol)
+		}
+		sb.WriteByte(' ')
+	}
+	cells := ParseStyles(sb.String(), style)
+	cells = append(cells, self.checkboxCells(node, style)...)
+	if decoration.Icon != "" {
+		cells = append(cells, ParseStyles(decoration.Icon+" ", style)...)
+	}
+	prefix := len(cells)
+
+	value := node.Value.String()
+	if shorten != nil {
+		value = shorten(value, maxWidth-rw.StringWidth(CellsToString(cells)))
+	}
+	return append(cells, ParseStyles(value, style)...), prefix
+}
+
 func (self *Tree) Draw(buf *Buffer) {
 	self.Block.Draw(buf)
+	self.selectionRect = image.Rectangle{}
 	point := self.Inner.Min
 
 	// adjusts view into widget
@@ -123,17 +240,35 @@ func (self *Tree) Draw(buf *Buffer) {
 	}
 
 	// draw rows
+	maxX := self.contentMaxX()
+	self.lineRows = self.lineRows[:0]
 	for row := self.topRow; row < len(self.rows) && point.Y < self.Inner.Max.Y; row++ {
-		cells := self.rows[row].parseStyles(self.TextStyle)
+		var shorten LabelShortener
+		if !self.WrapText {
+			shorten = self.LabelShortener
+		}
+		node := self.rows[row]
+		if row == self.SelectedRow {
+			self.selectionRect = image.Rect(self.Inner.Min.X, point.Y, self.Inner.Max.X, point.Y+1)
+		}
+		cells, prefix := self.nodeCells(node, maxX-self.Inner.Min.X, shorten)
+		matched := self.highlightMatches(node, cells[prefix:])
+		// WrapCells keeps the cells at their indices, so matched stays valid
 		if self.WrapText {
-			cells = WrapCells(cells, uint(self.Inner.Dx()))
+			cells = WrapCells(cells, uint(maxX-self.Inner.Min.X))
 		}
 		for j := 0; j < len(cells) && point.Y < self.Inner.Max.Y; j++ {
+			for len(self.lineRows) <= point.Y-self.Inner.Min.Y {
+				self.lineRows = append(self.lineRows, row)
+			}
 			style := cells[j].Style
-			if row == self.SelectedRow {
+			if row == self.SelectedRow && !matched[j-prefix] {
 				style = self.SelectedRowStyle
 			}
-			if point.X+1 == self.Inner.Max.X+1 && len(cells) > self.Inner.Dx() {
+			if node == self.dropTarget {
+				style = self.DropTargetStyle
+			}
+			if point.X == maxX && len(cells) > maxX-self.Inner.Min.X {
 				buf.SetCell(NewCell(ELLIPSES, style), point.Add(image.Pt(-1, 0)))
 			} else {
 				buf.SetCell(NewCell(cells[j].Rune, style), point)
@@ -143,6 +278,11 @@ func (self *Tree) Draw(buf *Buffer) {
 		point = image.Pt(self.Inner.Min.X, point.Y+1)
 	}
 
+	if self.Scrollbar != nil {
+		self.Scrollbar.Draw(buf, self.Inner.Max.X-1, self.Inner.Min.Y, self.Inner.Max.Y, len(self.rows), self.topRow, self.Inner.Dy())
+		return
+	}
+
 	// draw UP_ARROW if needed
 	if self.topRow > 0 {
 		buf.SetCell(
@@ -160,6 +300,12 @@ func (self *Tree) Draw(buf *Buffer) {
 	}
 }
 
+// SelectionRect returns the area of the first line of the SelectedRow as of the last Draw,
+// which is empty if it isn't visible.
+func (self *Tree) SelectionRect() image.Rectangle {
+	return self.selectionRect
+}
+
 // ScrollAmount scrolls by amount given. If amount is < 0, then scroll up.
 // There is no need to set self.topRow, as this will be set automatically when drawn,
 // since if the selected item is off screen then the topRow variable will change accordingly.
@@ -218,30 +364,56 @@ func (self *Tree) ScrollBottom() {
 }
 
 func (self *Tree) Collapse() {
-	self.rows[self.SelectedRow].Expanded = false
+	self.setExpanded(self.rows[self.SelectedRow], false)
 	self.prepareNodes()
 }
 
 func (self *Tree) Expand() {
 	node := self.rows[self.SelectedRow]
+	self.load(node)
 	if len(node.Nodes) > 0 {
-		self.rows[self.SelectedRow].Expanded = true
+		self.setExpanded(node, true)
 	}
 	self.prepareNodes()
 }
 
 func (self *Tree) ToggleExpand() {
 	node := self.rows[self.SelectedRow]
+	expanded := self.isExpanded(node)
+	if !expanded {
+		self.load(node)
+	}
 	if len(node.Nodes) > 0 {
-		node.Expanded = !node.Expanded
+		self.setExpanded(node, !expanded)
 	}
 	self.prepareNodes()
 }
 
+// load loads the children of a LazyTreeValue node if they weren't loaded yet.
+func (self *Tree) load(node *TreeNode) {
+	lazy, ok := node.Value.(LazyTreeValue)
+	if !ok || node.loaded || !lazy.HasChildren() {
+		return
+	}
+	nodes, err := lazy.LoadChildren()
+	if err != nil {
+		if self.OnLoadError != nil {
+			self.OnLoadError(node, err)
+		}
+		return
+	}
+	node.Nodes = nodes
+	node.loaded = true
+	// loaded children of a checked node are checked, too
+	if node.Checked {
+		node.setChecked(true)
+	}
+}
+
 func (self *Tree) ExpandAll() {
 	self.Walk(func(n *TreeNode) bool {
 		if len(n.Nodes) > 0 {
-			n.Expanded = true
+			self.setExpanded(n, true)
 		}
 		return true
 	})
@@ -250,7 +422,7 @@ func (self *Tree) ExpandAll() {
 
 func (self *Tree) CollapseAll() {
 	self.Walk(func(n *TreeNode) bool {
-		n.Expanded = false
+		self.setExpanded(n, false)
 		return true
 	})
 	self.prepareNodes()
@@ -0,0 +1,78 @@
+package widgets
+
+import (
+	. "github.com/s-westphal/termui/v3"
+)
+
+// TreeCheckState is the checkbox state of a TreeNode.
+type TreeCheckState uint
+
+const (
+	TreeUnchecked TreeCheckState = iota
+	TreeChecked
+	// TreePartiallyChecked is the state of nodes with checked and unchecked children.
+	TreePartiallyChecked
+)
+
+var treeCheckboxes = map[TreeCheckState]string{
+	TreeUnchecked:        listUnchecked,
+	TreeChecked:          listChecked,
+	TreePartiallyChecked: "[-] ",
+}
+
+// CheckState returns the checkbox state of a node. The state of nodes with children
+// is derived from the children, the state of leaves is their Checked field.
+func (self *TreeNode) CheckState() TreeCheckState {
+	if len(self.Nodes) == 0 {
+		if self.Checked {
+			return TreeChecked
+		}
+		return TreeUnchecked
+	}
+	state := self.Nodes[0].CheckState()
+	for _, n := range self.Nodes[1:] {
+		if n.CheckState() != state {
+			return TreePartiallyChecked
+		}
+	}
+	return state
+}
+
+// setChecked checks or unchecks the node and all its descendants.
+func (self *TreeNode) setChecked(checked bool) {
+	self.Checked = checked
+	for _, n := range self.Nodes {
+		n.setChecked(checked)
+	}
+}
+
+// ToggleChecked checks or unchecks the selected node and its descendants in Checkboxes mode,
+// e.g. when Space is pressed. Partially checked nodes become checked.
+func (self *Tree) ToggleChecked() {
+	node := self.SelectedNode()
+	if !self.Checkboxes || node == nil {
+		return
+	}
+	node.setChecked(node.CheckState() != TreeChecked)
+}
+
+// CheckedNodes returns the checked nodes in tree order, including the nodes with
+// all children checked.
+func (self *Tree) CheckedNodes() []*TreeNode {
+	nodes := []*TreeNode{}
+	self.Walk(func(n *TreeNode) bool {
+		if n.CheckState() == TreeChecked {
+			nodes = append(nodes, n)
+		}
+		return true
+	})
+	return nodes
+}
+
+// checkboxCells returns the checkbox of the node in Checkboxes mode.
+func (self *Tree) checkboxCells(node *TreeNode, style Style) []Cell {
+	if !self.Checkboxes {
+		return nil
+	}
+	return RunesToStyledCells([]rune(treeCheckboxes[node.CheckState()]), style)
+}
@@ -0,0 +1,107 @@
+package widgets
+
+import (
+	"image"
+
+	. "github.com/s-westphal/termui/v3"
+)
+
+// nodeAt returns the node drawn at the given point, or nil if there is none.
+func (self *Tree) nodeAt(p image.Point) *TreeNode {
+	if !p.In(self.Inner) {
+		return nil
+	}
+	if line := p.Y - self.Inner.Min.Y; line < len(self.lineRows) && self.lineRows[line] < len(self.rows) {
+		return self.rows[self.lineRows[line]]
+	}
+	return nil
+}
+
+// isAncestor reports whether node is an ancestor of other or other itself.
+func (self *TreeNode) isAncestor(other *TreeNode) bool {
+	if self == other {
+		return true
+	}
+	for _, n := range self.Nodes {
+		if n.isAncestor(other) {
+			return true
+		}
+	}
+	return false
+}
+
+// removeNode removes node from the roots or the children of its parent.
+func (self *Tree) removeNode(node *TreeNode) {
+	remove := func(nodes []*TreeNode) ([]*TreeNode, bool) {
+		for i, n := range nodes {
+			if n == node {
+				return append(nodes[:i:i], nodes[i+1:]...), true
+			}
+		}
+		return nodes, false
+	}
+	var removed bool
+	if self.nodes, removed = remove(self.nodes); removed {
+		return
+	}
+	self.Walk(func(n *TreeNode) bool {
+		n.Nodes, removed = remove(n.Nodes)
+		return !removed
+	})
+}
+
+// Reparent moves node to the end of the children of parent and expands parent.
+// A node can't be moved into itself or its descendants.
+func (self *Tree) Reparent(node, parent *TreeNode) {
+	if node == nil || parent == nil || node.isAncestor(parent) {
+		return
+	}
+	self.load(parent)
+	self.removeNode(node)
+	parent.Nodes = append(parent.Nodes, node)
+	self.setExpanded(parent, true)
+	self.prepareNodes()
+	for i, n := range self.rows {
+		if n == node {
+			self.SelectedRow = i
+		}
+	}
+}
+
+// HandleDragEvent lets nodes be grabbed with the left mouse button and dropped onto another
+// node, which becomes their parent, see OnReparent. It returns whether the event was used.
+func (self *Tree) HandleDragEvent(e Event) bool {
+	mouse, ok := e.Payload.(Mouse)
+	if e.Type != MouseEvent || !ok {
+		return false
+	}
+	switch e.ID {
+	case "<MouseLeft>":
+		node := self.nodeAt(image.Pt(mouse.X, mouse.Y))
+		if !mouse.Drag || self.dragged == nil {
+			if node == nil {
+				return false
+			}
+			self.SelectedRow = self.lineRows[mouse.Y-self.Inner.Min.Y]
+			self.dragged = node
+			self.dropTarget = nil
+			return true
+		}
+		self.dropTarget = nil
+		if node != nil && !self.dragged.isAncestor(node) {
+			self.dropTarget = node
+		}
+		return true
+	case "<MouseRelease>":
+		node, target := self.dragged, self.dropTarget
+		self.dragged, self.dropTarget = nil, nil
+		if node == nil {
+			return false
+		}
+		if target != nil && (self.OnReparent == nil || self.OnReparent(node, target)) {
+			self.Reparent(node, target)
+		}
+		return true
+	}
+	return false
+}
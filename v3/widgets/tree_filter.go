@@ -0,0 +1,126 @@
+package widgets
+
+import (
+	. "github.com/s-westphal/termui/v3"
+)
+
+// Filter hides the nodes that don't match query according to the Matcher, except for
+// the ancestors of matching nodes, which are shown expanded until they are collapsed.
+// The matched text is highlighted with MatchStyle. Only loaded nodes are searched,
+// see LazyTreeValue. An empty query shows all nodes again.
+func (self *Tree) Filter(query string) {
+	selected := self.SelectedNode()
+	self.query = query
+	self.collapsed = make(map[*TreeNode]bool)
+	self.prepareNodes()
+	self.SelectedRow = 0
+	for i, node := range self.rows {
+		if node == selected {
+			self.SelectedRow = i
+			return
+		}
+	}
+	if query != "" {
+		self.NextMatch()
+	}
+}
+
+// NextMatch selects the next node matching the Filter query, wrapping around at the end.
+func (self *Tree) NextMatch() {
+	self.selectMatch(1)
+}
+
+// PrevMatch selects the previous node matching the Filter query, wrapping around at the start.
+func (self *Tree) PrevMatch() {
+	self.selectMatch(-1)
+}
+
+func (self *Tree) selectMatch(step int) {
+	n := len(self.rows)
+	if n == 0 {
+		return
+	}
+	for i := 1; i <= n; i++ {
+		row := ((self.SelectedRow+i*step)%n + n) % n
+		if self.matches[self.rows[row]] {
+			self.SelectedRow = row
+			return
+		}
+	}
+}
+
+// match returns whether the text matches the Filter query and the indices of the matched runes.
+func (self *Tree) match(text string) (bool, []int) {
+	matcher := self.Matcher
+	if matcher == nil {
+		matcher = SubstringMatcher
+	}
+	return matcher(text, self.query)
+}
+
+// filterNodes collects the nodes matching the Filter query and their ancestors.
+func (self *Tree) filterNodes() {
+	self.matches = make(map[*TreeNode]bool)
+	self.visible = make(map[*TreeNode]bool)
+	var visit func(node *TreeNode) bool
+	visit = func(node *TreeNode) bool {
+		visible := false
+		if ok, _ := self.match(CellsToString(ParseStyles(node.Value.String(), self.TextStyle))); ok {
+			self.matches[node] = true
+			visible = true
+		}
+		for _, n := range node.Nodes {
+			if visit(n) {
+				visible = true
+			}
+		}
+		self.visible[node] = visible
+		return visible
+	}
+	for _, node := range self.nodes {
+		visit(node)
+	}
+}
+
+// isExpanded reports whether the children of the node are shown. While filtering,
+// nodes are shown expanded if any of their children is visible, unless they were
+// collapsed since.
+func (self *Tree) isExpanded(node *TreeNode) bool {
+	if self.query == "" {
+		return node.Expanded
+	}
+	if self.collapsed[node] {
+		return false
+	}
+	for _, n := range node.Nodes {
+		if self.visible[n] {
+			return true
+		}
+	}
+	return false
+}
+
+// setExpanded expands or collapses the node, which overrides the expansion by the Filter.
+func (self *Tree) setExpanded(node *TreeNode, expanded bool) {
+	node.Expanded = expanded
+	if self.query != "" {
+		self.collapsed[node] = !expanded
+	}
+}
+
+// highlightMatches sets the MatchStyle on the matched cells of the node value
+// and returns their indices.
+func (self *Tree) highlightMatches(node *TreeNode, cells []Cell) map[int]bool {
+	if self.query == "" || !self.matches[node] {
+		return nil
+	}
+	_, indices := self.match(CellsToString(cells))
+	matched := make(map[int]bool, len(indices))
+	for _, i := range indices {
+		if i < len(cells) {
+			cells[i].Style = self.MatchStyle
+			matched[i] = true
+		}
+	}
+	return matched
+}
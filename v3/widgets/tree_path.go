@@ -0,0 +1,67 @@
+package widgets
+
+// contentMaxX returns the right edge of the rows, which leave room for the Scrollbar.
+func (self *Tree) contentMaxX() int {
+	if self.Scrollbar != nil {
+		return self.Inner.Max.X - 1
+	}
+	return self.Inner.Max.X
+}
+
+// findPath returns the nodes along path, which are given by the String of their Value,
+// starting at the roots. Children of lazy nodes are loaded as needed. The returned nodes
+// end early if a node of the path doesn't exist.
+func (self *Tree) findPath(path []string) []*TreeNode {
+	found := []*TreeNode{}
+	nodes := self.nodes
+	for _, name := range path {
+		var next *TreeNode
+		for _, n := range nodes {
+			if n.Value.String() == name {
+				next = n
+				break
+			}
+		}
+		if next == nil {
+			break
+		}
+		found = append(found, next)
+		self.load(next)
+		nodes = next.Nodes
+	}
+	return found
+}
+
+// ExpandPath expands all nodes along path, which are given by the String of their Value
+// starting at a root node, e.g. "src", "widgets". It reports whether the whole path exists.
+func (self *Tree) ExpandPath(path ...string) bool {
+	found := self.findPath(path)
+	for _, n := range found {
+		if len(n.Nodes) > 0 {
+			self.setExpanded(n, true)
+		}
+	}
+	self.prepareNodes()
+	return len(found) == len(path)
+}
+
+// ScrollToNode expands the ancestors of the node at path, see ExpandPath, and selects it,
+// which scrolls it into view. It reports whether the node exists.
+func (self *Tree) ScrollToNode(path ...string) bool {
+	found := self.findPath(path)
+	if len(found) != len(path) || len(path) == 0 {
+		return false
+	}
+	for _, n := range found[:len(found)-1] {
+		self.setExpanded(n, true)
+	}
+	self.prepareNodes()
+	for i, n := range self.rows {
+		if n == found[len(found)-1] {
+			self.SelectedRow = i
+			return true
+		}
+	}
+	// the node is hidden by the Filter
+	return false
+}
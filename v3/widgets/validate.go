@@ -0,0 +1,116 @@
+package widgets
+
+import (
+	"fmt"
+)
+
+// Validate checks that all rows have as many columns as the header and ColumnWidths.
+func (self *Table) Validate() error {
+	if self.DataProvider != nil || len(self.Rows) == 0 {
+		return nil
+	}
+	columns := len(self.Rows[0])
+	if len(self.ColumnWidths) > 0 && len(self.ColumnWidths) != columns {
+		return fmt.Errorf("ColumnWidths has %d entries, but the header has %d columns", len(self.ColumnWidths), columns)
+	}
+	for i, row := range self.Rows {
+		if len(row) != columns {
+			return fmt.Errorf("row %d has %d columns, but the header has %d", i, len(row), columns)
+		}
+	}
+	return nil
+}
+
+// Validate checks that scatter plots have x and y values of equal length, that every data point
+// has a category if Categories are set and that the scale is positive.
+func (self *Plot) Validate() error {
+	if self.HorizontalScale < 1 {
+		return fmt.Errorf("HorizontalScale is %d, but must be at least 1", self.HorizontalScale)
+	}
+	if self.PlotType == ScatterPlot {
+		if len(self.Data) != 2 {
+			return fmt.Errorf("scatter plots need 2 data series for x and y, got %d", len(self.Data))
+		}
+		if len(self.Data[0]) != len(self.Data[1]) {
+			return fmt.Errorf("scatter plot has %d x values, but %d y values", len(self.Data[0]), len(self.Data[1]))
+		}
+	}
+	for i, line := range self.Data {
+		if len(self.Categories) > 0 && len(self.Categories) < len(line) {
+			return fmt.Errorf("line %d has %d data points, but there are only %d Categories", i, len(line), len(self.Categories))
+		}
+	}
+	return nil
+}
+
+// Validate checks that Percent is between 0 and 100 and that the Segments don't exceed 100%.
+func (self *Gauge) Validate() error {
+	if self.Percent < 0 || self.Percent > 100 {
+		return fmt.Errorf("Percent is %d, but must be between 0 and 100", self.Percent)
+	}
+	total := 0
+	for _, segment := range self.Segments {
+		total += segment.Percent
+	}
+	if total > 100 {
+		return fmt.Errorf("Segments add up to %d%%, but must not exceed 100%%", total)
+	}
+	return nil
+}
+
+// Validate checks that there are no more labels than bars and that the bars are wide enough.
+func (self *BarChart) Validate() error {
+	bars := len(self.Data)
+	if len(self.Series) > 0 {
+		bars = 0
+		for _, series := range self.Series {
+			if len(series) > bars {
+				bars = len(series)
+			}
+		}
+	}
+	if len(self.Labels) > bars {
+		return fmt.Errorf("there are %d labels, but only %d bars", len(self.Labels), bars)
+	}
+	if self.BarWidth < 1 {
+		return fmt.Errorf("BarWidth is %d, but must be at least 1", self.BarWidth)
+	}
+	return nil
+}
+
+// Validate checks that there are no more labels than bars and that the bars are wide enough.
+func (self *StackedBarChart) Validate() error {
+	if len(self.Labels) > len(self.Data) {
+		return fmt.Errorf("there are %d labels, but only %d bars", len(self.Labels), len(self.Data))
+	}
+	if self.BarWidth < 1 {
+		return fmt.Errorf("BarWidth is %d, but must be at least 1", self.BarWidth)
+	}
+	return nil
+}
+
+// Validate checks that there are no negative slices.
+func (self *PieChart) Validate() error {
+	for i, value := range self.Data {
+		if value < 0 {
+			return fmt.Errorf("slice %d is negative: %v", i, value)
+		}
+	}
+	return nil
+}
+
+// Validate checks that the ActiveTabIndex refers to a tab.
+func (self *TabPane) Validate() error {
+	if len(self.TabNames) > 0 && (self.ActiveTabIndex < 0 || self.ActiveTabIndex >= len(self.TabNames)) {
+		return fmt.Errorf("ActiveTabIndex is %d, but there are %d tabs", self.ActiveTabIndex, len(self.TabNames))
+	}
+	return nil
+}
+
+// Validate checks that the SelectedRow refers to a row.
+func (self *List) Validate() error {
+	if count := self.rowCount(); count > 0 && (self.SelectedRow < 0 || self.SelectedRow >= count) {
+		return fmt.Errorf("SelectedRow is %d, but there are %d rows", self.SelectedRow, count)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package termui
+
+import (
+	rw "github.com/mattn/go-runewidth"
+)
+
+// WrapMode is where text that doesn't fit is broken into lines.
+type WrapMode uint
+
+const (
+	// WrapWord breaks lines between words. Words that are longer than a line
+	// are only broken up if hyphenation is enabled.
+	WrapWord WrapMode = iota
+	// WrapChar breaks lines at the last rune that fits, even within words.
+	WrapChar
+)
+
+// WrapCellsWith takes []Cell and inserts Cells containing '\n' wherever a linebreak should go
+// according to mode. If hyphenate is set, words that are broken up end with a hyphen.
+func WrapCellsWith(cells []Cell, width int, mode WrapMode, hyphenate bool) []Cell {
+	width = MaxInt(width, 1)
+	if mode == WrapWord {
+		cells = WrapCells(cells, uint(width))
+		if !hyphenate {
+			return cells
+		}
+	}
+	wrapped := []Cell{}
+	start := 0
+	for i := 0; i <= len(cells); i++ {
+		if i < len(cells) && cells[i].Rune != '\n' {
+			continue
+		}
+		if start > 0 {
+			wrapped = append(wrapped, Cell{'\n', StyleClear})
+		}
+		for j, line := range wrapLineChars(cells[start:i], width, hyphenate) {
+			if j > 0 {
+				wrapped = append(wrapped, Cell{'\n', StyleClear})
+			}
+			wrapped = append(wrapped, line...)
+		}
+		start = i + 1
+	}
+	return wrapped
+}
+
+// wrapLineChars breaks a line at the last rune that fits into width.
+// The space at a break is dropped.
+func wrapLineChars(line []Cell, width int, hyphenate bool) [][]Cell {
+	lines := [][]Cell{}
+	current := []Cell{}
+	currentWidth := 0
+	for _, cell := range line {
+		cellWidth := rw.RuneWidth(cell.Rune)
+		if currentWidth+cellWidth > width && len(current) > 0 {
+			next := []Cell{}
+			last := len(current) - 1
+			// the hyphen takes the place of the last rune of the line, unless it would be
+			// left on its own after a space
+			if hyphenate && cell.Rune != ' ' && current[last].Rune != ' ' && last > 0 && current[last-1].Rune != ' ' {
+				next = append(next, current[last])
+				current = append(current[:last:last], Cell{'-', current[last].Style})
+			}
+			lines = append(lines, current)
+			current = next
+			currentWidth = 0
+			for _, c := range next {
+				currentWidth += rw.RuneWidth(c.Rune)
+			}
+			if cell.Rune == ' ' && len(current) == 0 {
+				continue
+			}
+		}
+		current = append(current, cell)
+		currentWidth += cellWidth
+	}
+	return append(lines, current)
+}